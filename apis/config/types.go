@@ -280,6 +280,184 @@ type SySchedArgs struct {
 	DefaultProfileName string
 }
 
+// CarbonDataProviderType is a "string" type.
+type CarbonDataProviderType string
+
+const (
+	SICCarbonDataProvider                CarbonDataProviderType = "SIC"
+	StaticFileCarbonDataProvider         CarbonDataProviderType = "StaticFile"
+	HTTPJSONCarbonDataProvider           CarbonDataProviderType = "HTTPJSON"
+	PrometheusCarbonDataProvider         CarbonDataProviderType = "Prometheus"
+	NodeSustainabilityCarbonDataProvider CarbonDataProviderType = "NodeSustainability"
+)
+
+// DecayModelType is a "string" type.
+type DecayModelType string
+
+const (
+	LogisticDecayModel      DecayModelType = "Logistic"
+	ExponentialDecayModel   DecayModelType = "Exponential"
+	LinearDecayModel        DecayModelType = "Linear"
+	SlidingWindowDecayModel DecayModelType = "SlidingWindow"
+	EWMADecayModel          DecayModelType = "EWMA"
+)
+
+// ScoreNormalizationType is a "string" type.
+type ScoreNormalizationType string
+
+const (
+	MaxRatioScoreNormalization ScoreNormalizationType = "MaxRatio"
+	MinMaxScoreNormalization   ScoreNormalizationType = "MinMax"
+	RankScoreNormalization     ScoreNormalizationType = "Rank"
+	ZScoreScoreNormalization   ScoreNormalizationType = "ZScore"
+)
+
+// EmbodiedCarbonPreferenceType is a "string" type.
+type EmbodiedCarbonPreferenceType string
+
+const (
+	// Favour hardware whose embodied carbon is already amortised, i.e. older hardware
+	AmortizedEmbodiedCarbonPreference EmbodiedCarbonPreferenceType = "Amortized"
+	// Favour hardware whose embodied carbon is still to be amortised, i.e. newer hardware
+	NewerEmbodiedCarbonPreference EmbodiedCarbonPreferenceType = "Newer"
+)
+
+// EntityAggregationType is a "string" type.
+type EntityAggregationType string
+
+const (
+	// Sum the usage of the SIC entities of a node, e.g. of a chassis and its blades
+	SumEntityAggregation EntityAggregationType = "Sum"
+	// Average the usage of the SIC entities of a node, weighted by their energy consumption, e.g. of duplicate records of the same server
+	AverageEntityAggregation EntityAggregationType = "Average"
+)
+
+// ScoreBreakdownType is a "string" type.
+type ScoreBreakdownType string
+
+const (
+	// Do not annotate pods with the breakdown of their sustainability scores
+	DisabledScoreBreakdown ScoreBreakdownType = "Disabled"
+	// Annotate the pods requesting it, with the "true" explain-score annotation, with the breakdown of their sustainability scores
+	OptInScoreBreakdown ScoreBreakdownType = "OptIn"
+	// Annotate every pod with the breakdown of its sustainability scores
+	AlwaysScoreBreakdown ScoreBreakdownType = "Always"
+)
+
+// Denote the spec of the static file carbon data provider
+type StaticFileProviderSpec struct {
+	// Path to a JSON or YAML file, e.g. a mounted ConfigMap, holding the carbon data of nodes
+	Path string
+}
+
+// Denote the JSON fields the HTTP/JSON carbon data provider reads its values from.
+// Fields are dot-separated paths into the response document.
+type HTTPJSONFieldMappings struct {
+	// Path to the array holding the emission time series
+	Series string
+	// Path to the timestamp within a series item
+	Time string
+	// Path to the CO2 emissions (metric tons) within a series item
+	CO2 string
+	// Path to the energy consumption (kWh) within a series item
+	Kwh string
+	// Path to the total CO2 emissions (metric tons)
+	TotalCO2 string
+	// Path to the total cost (USD)
+	TotalCost string
+	// Path to the total energy consumption (kWh)
+	TotalKwh string
+}
+
+// Denote the spec of the HTTP/JSON carbon data provider
+type HTTPJSONProviderSpec struct {
+	// URL template queried per node; {key}, {startTime} and {endTime} are substituted
+	URL string
+	// The bearer token sent with every request, if any
+	Token string
+	// The fields the carbon data is read from
+	FieldMappings HTTPJSONFieldMappings
+}
+
+// Denote the spec of the Prometheus carbon data provider
+type PrometheusProviderSpec struct {
+	// Address of the Prometheus server
+	Address string
+	// The bearer token sent with every request, if any
+	Token string
+	// PromQL range query returning the energy, in joules, consumed by a node during each step;
+	// {key} and {step} are substituted
+	EnergyQuery string
+	// Resolution, in seconds, of the range query
+	StepSeconds int64
+	// Label of the query result series holding the region of the node
+	RegionLabel string
+	// Grid carbon intensity, in gCO2e/kWh, per region
+	CarbonIntensity map[string]float64
+	// Grid carbon intensity, in gCO2e/kWh, of regions missing from CarbonIntensity
+	DefaultCarbonIntensity float64
+}
+
+// Denote the deferral of delay-tolerant pods while carbon emissions are high
+type CarbonDeferralSpec struct {
+	// Decayed CO2 trend of a node, in metric tons per time series interval, above which delay-tolerant
+	// pods bound to it are held in Permit; 0 disables deferral
+	CO2Threshold float64
+	// Interval, in seconds, at which deferred pods are re-evaluated
+	CheckPeriodSeconds int64
+	// Time, in seconds, before the deadline of a deferred pod at which it is released regardless of the CO2 trend
+	DeadlineMarginSeconds int64
+}
+
+// Denote the consolidation mode, blending the sustainability score of nodes with bin-packing pressure,
+// so that pods pack onto efficient nodes and lightly used, carbon-intensive nodes drain and can be removed
+type ConsolidationSpec struct {
+	// Share of the score given to bin-packing, from 0, scoring by sustainability only and disabling
+	// consolidation, to 1, scoring by the requested share of the allocatable CPU and memory only
+	PackingWeight float64
+}
+
+// Denote the maximum carbon emissions and energy consumption of the nodes pods may be scheduled on
+type CarbonCeilingSpec struct {
+	// Maximum CO2 emissions, in metric tons, of a node over the consideration window; 0 disables the ceiling
+	MaxCO2 float64
+	// Maximum energy consumption, in kWh, of a node over the consideration window; 0 disables the ceiling
+	MaxKwh float64
+}
+
+// Denote the scoring of the embodied carbon of the hardware of nodes, i.e. the CO2 emitted to manufacture it
+type EmbodiedCarbonSpec struct {
+	// Weight for embodied carbon in scoring; 0 disables the embodied carbon term
+	Weight float64
+	// Expected lifetime, in years, of the hardware, over which its manufacturing footprint is amortised
+	LifetimeYears float64
+	// Hardware favoured by the embodied carbon term
+	Preference EmbodiedCarbonPreferenceType
+	// Manufacturing footprint, in metric tons of CO2, per hardware model, keyed by "<make>/<model>"
+	Footprints map[string]float64
+}
+
+// Denote the fallback of nodes without the serial number label to the carbon data of their location,
+// mapping their topology labels to SIC location filters
+type LocationFallbackSpec struct {
+	// SIC location filter key, e.g. locationCity, matched against the topology.kubernetes.io/zone label; empty to ignore zones
+	ZoneFilterKey string
+	// SIC location filter key, e.g. locationCountry, matched against the topology.kubernetes.io/region label; empty to ignore regions
+	RegionFilterKey string
+	// SIC location per zone or region label value; label values missing from the map are used as is
+	Locations map[string]string
+}
+
+// Denote a key of a Secret
+type SecretKeyRef struct {
+	// Namespace of the Secret
+	Namespace string
+	// Name of the Secret
+	Name string
+	// Key within the data of the Secret
+	Key string
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // GreenSchedulingArgs holds arguments used to configure GreenScheduling plugin.
@@ -292,12 +470,26 @@ type GreenSchedulingArgs struct {
 	// Client ID for authentication
 	ClientID string
 
-	// Client Secret for authentication
+	// Client Secret for authentication, in plain text. Prefer ClientSecretRef or ClientSecretFile
 	ClientSecret string
 
+	// Reference to a Secret key holding the Client Secret, re-read whenever the Secret changes
+	ClientSecretRef SecretKeyRef
+
+	// Path to a file, e.g. a mounted Secret, holding the Client Secret, re-read on every token request
+	ClientSecretFile string
+
 	// Hostname for the SIC API
 	SICHostname string
 
+	// Base URL of the SIC API, e.g. "http://sic.example.com:8080", overriding SICHostname,
+	// which implies HTTPS on the default port
+	SICBaseURL string
+
+	// Path to a PEM file of CA certificates trusted, in addition to the system ones, by
+	// requests to the SIC API and the token URL
+	SICCAFile string
+
 	// Weight for CO2 decay in scoring
 	CO2DecayWeight float64
 
@@ -310,6 +502,21 @@ type GreenSchedulingArgs struct {
 	// Decay rate used for CO2 calculations
 	DecayRate float64
 
+	// Model weighing emission data points by their age
+	DecayModel DecayModelType
+
+	// Window, in hours, of the Linear and SlidingWindow decay models
+	DecayWindowHours float64
+
+	// Half-life, in hours, of the EWMA decay model
+	DecayHalfLifeHours float64
+
+	// Weight for energy consumption decay in scoring
+	EnergyDecayWeight float64
+
+	// Weight for total energy consumption in scoring
+	EnergyWeight float64
+
 	// Time interval for time series calculations
 	TimeSeriesInterval string
 
@@ -318,4 +525,48 @@ type GreenSchedulingArgs struct {
 
 	// Label key to identify the node serial number
 	SerialNumLabel string
+
+	// Strategy scaling the raw sustainability scores of nodes to the framework's score range
+	ScoreNormalization ScoreNormalizationType
+
+	// Maximum age, in seconds, of a cached sustainability score before it is discarded
+	ScoreCacheTTLSeconds int64
+
+	// Interval, in seconds, at which cached sustainability scores are refreshed in the background
+	ScoreCacheRefreshPeriodSeconds int64
+
+	// Source of the carbon data used for scoring
+	Provider CarbonDataProviderType
+
+	// Spec of the static file carbon data provider
+	StaticFileProvider StaticFileProviderSpec
+
+	// Spec of the HTTP/JSON carbon data provider
+	HTTPJSONProvider HTTPJSONProviderSpec
+
+	// Spec of the Prometheus carbon data provider
+	PrometheusProvider PrometheusProviderSpec
+
+	// Deferral of delay-tolerant pods while carbon emissions are high
+	CarbonDeferral CarbonDeferralSpec
+
+	// Ceiling filtering out nodes with high carbon emissions or energy consumption, overridable per pod
+	CarbonCeiling CarbonCeilingSpec
+
+	// Scoring of the embodied carbon of the hardware of nodes
+	EmbodiedCarbon EmbodiedCarbonSpec
+
+	// Fallback of nodes without the serial number label to the carbon data of their location
+	LocationFallback LocationFallbackSpec
+
+	// Aggregation of the usage of multiple SIC entities matching the serial number of a node
+	EntityAggregation EntityAggregationType
+
+	// Consolidation of pods onto fewer, greener nodes, so that idle nodes can be removed
+	Consolidation ConsolidationSpec
+
+	// Whether the scores of nodes are scaled by the time windows of the CarbonSchedule objects selecting them
+	EnableCarbonSchedules bool
+	// Which pods are annotated with the breakdown of their sustainability scores after binding, each annotation costing a pod update
+	ScoreBreakdown ScoreBreakdownType
 }
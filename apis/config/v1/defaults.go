@@ -112,10 +112,68 @@ var (
 	DefaultCostWeight = 0.1
 	// DefaultDecayRate is the default rate used for CO2 calculations
 	DefaultDecayRate = 0.05
+	// DefaultDecayModel is the default model weighing emission data points by their age
+	DefaultDecayModel = LogisticDecayModel
+	// DefaultDecayWindowHours is the default window of the Linear and SlidingWindow decay models
+	DefaultDecayWindowHours = 24.0
+	// DefaultDecayHalfLifeHours is the default half-life of the EWMA decay model
+	DefaultDecayHalfLifeHours = 24.0
+	// DefaultEnergyDecayWeight is the default weight for energy consumption decay in scoring
+	DefaultEnergyDecayWeight = 0.0
+	// DefaultEnergyWeight is the default weight for total energy consumption in scoring
+	DefaultEnergyWeight = 0.0
 	// DefaultTimeSeriesInterval is the default interval for the TimeSeries plugin
 	DefaultTimeSeriesInterval = "1 day"
 	// DefaultConsiderationDays is the default number of days for the TimeSeries plugin
 	DefaultConsiderationDays = 30.0
+	// DefaultScoreNormalization is the default strategy scaling raw sustainability scores to the framework's score range
+	DefaultScoreNormalization = MaxRatioScoreNormalization
+	// DefaultScoreCacheTTLSeconds is the default maximum age of a cached sustainability score
+	DefaultScoreCacheTTLSeconds int64 = 3600
+	// DefaultScoreCacheRefreshPeriodSeconds is the default interval between sustainability score cache refreshes
+	DefaultScoreCacheRefreshPeriodSeconds int64 = 300
+	// DefaultCarbonDataProvider is the default source of the carbon data used for scoring
+	DefaultCarbonDataProvider = SICCarbonDataProvider
+	// Default fields the HTTP/JSON carbon data provider reads its values from
+	DefaultHTTPJSONSeriesField    = "series"
+	DefaultHTTPJSONTimeField      = "time"
+	DefaultHTTPJSONCO2Field       = "co2"
+	DefaultHTTPJSONKwhField       = "kwh"
+	DefaultHTTPJSONTotalCO2Field  = "totalCO2"
+	DefaultHTTPJSONTotalCostField = "totalCost"
+	DefaultHTTPJSONTotalKwhField  = "totalKwh"
+	// DefaultPrometheusEnergyQuery is the default query of the Prometheus carbon data provider, reading Kepler node energy counters
+	DefaultPrometheusEnergyQuery = `sum(increase(kepler_node_platform_joules_total{instance="{key}"}[{step}]))`
+	// DefaultPrometheusStepSeconds is the default resolution of the Prometheus carbon data provider range query
+	DefaultPrometheusStepSeconds int64 = 3600
+	// DefaultPrometheusRegionLabel is the default label of the Prometheus query result series holding the region of the node
+	DefaultPrometheusRegionLabel = "region"
+	// DefaultCarbonIntensity is the default grid carbon intensity, in gCO2e/kWh, of regions with no configured carbon intensity
+	DefaultCarbonIntensity = 475.0
+	// DefaultCarbonDeferralCO2Threshold is the default CO2 trend above which delay-tolerant pods are deferred, 0 disabling deferral
+	DefaultCarbonDeferralCO2Threshold = 0.0
+	// DefaultCarbonDeferralCheckPeriodSeconds is the default interval at which deferred pods are re-evaluated
+	DefaultCarbonDeferralCheckPeriodSeconds int64 = 60
+	// DefaultCarbonDeferralDeadlineMarginSeconds is the default time before its deadline at which a deferred pod is released
+	DefaultCarbonDeferralDeadlineMarginSeconds int64 = 300
+	// DefaultCarbonCeilingMaxCO2 is the default CO2 ceiling of nodes, 0 disabling the ceiling
+	DefaultCarbonCeilingMaxCO2 = 0.0
+	// DefaultCarbonCeilingMaxKwh is the default energy ceiling of nodes, 0 disabling the ceiling
+	DefaultCarbonCeilingMaxKwh = 0.0
+	// DefaultEmbodiedCarbonWeight is the default weight for embodied carbon in scoring, 0 disabling the embodied carbon term
+	DefaultEmbodiedCarbonWeight = 0.0
+	// DefaultEmbodiedCarbonLifetimeYears is the default expected lifetime of hardware
+	DefaultEmbodiedCarbonLifetimeYears = 5.0
+	// DefaultEmbodiedCarbonPreference is the default hardware favoured by the embodied carbon term
+	DefaultEmbodiedCarbonPreference = AmortizedEmbodiedCarbonPreference
+	// DefaultEntityAggregation is the default aggregation of the SIC entities matching a serial number
+	DefaultEntityAggregation = SumEntityAggregation
+	// DefaultConsolidationPackingWeight is the default share of the score given to bin-packing, 0 disabling consolidation
+	DefaultConsolidationPackingWeight = 0.0
+	// DefaultEnableCarbonSchedules is the default of whether CarbonSchedule objects are applied to the scores of nodes
+	DefaultEnableCarbonSchedules = false
+	// DefaultScoreBreakdown is the default of which pods are annotated with the breakdown of their sustainability scores
+	DefaultScoreBreakdown = DisabledScoreBreakdown
 )
 
 // SetDefaults_CoschedulingArgs sets the default parameters for Coscheduling plugin.
@@ -291,6 +349,33 @@ func SetDefaults_GreenSchedulingArgs(obj *GreenSchedulingArgs) {
 		obj.DecayRate = &defaultDecayRate
 	}
 
+	// Set default value for DecayModel if not provided
+	if obj.DecayModel == "" {
+		obj.DecayModel = DefaultDecayModel
+	}
+
+	// Set default value for DecayWindowHours if not provided
+	if obj.DecayWindowHours == nil {
+		obj.DecayWindowHours = &DefaultDecayWindowHours
+	}
+
+	// Set default value for DecayHalfLifeHours if not provided
+	if obj.DecayHalfLifeHours == nil {
+		obj.DecayHalfLifeHours = &DefaultDecayHalfLifeHours
+	}
+
+	// Set default value for EnergyDecayWeight if not provided
+	if obj.EnergyDecayWeight == nil {
+		defaultEnergyDecayWeight := DefaultEnergyDecayWeight
+		obj.EnergyDecayWeight = &defaultEnergyDecayWeight
+	}
+
+	// Set default value for EnergyWeight if not provided
+	if obj.EnergyWeight == nil {
+		defaultEnergyWeight := DefaultEnergyWeight
+		obj.EnergyWeight = &defaultEnergyWeight
+	}
+
 	// Set default value for TimeSeriesInterval if not provided
 	if obj.TimeSeriesInterval == nil {
 		obj.TimeSeriesInterval = &DefaultTimeSeriesInterval
@@ -300,4 +385,116 @@ func SetDefaults_GreenSchedulingArgs(obj *GreenSchedulingArgs) {
 	if obj.ConsiderationDays == nil {
 		obj.ConsiderationDays = &DefaultConsiderationDays
 	}
+
+	// Set default value for ScoreNormalization if not provided
+	if obj.ScoreNormalization == "" {
+		obj.ScoreNormalization = DefaultScoreNormalization
+	}
+
+	// Set default value for ScoreCacheTTLSeconds if not provided
+	if obj.ScoreCacheTTLSeconds == nil {
+		obj.ScoreCacheTTLSeconds = &DefaultScoreCacheTTLSeconds
+	}
+
+	// Set default value for ScoreCacheRefreshPeriodSeconds if not provided
+	if obj.ScoreCacheRefreshPeriodSeconds == nil {
+		obj.ScoreCacheRefreshPeriodSeconds = &DefaultScoreCacheRefreshPeriodSeconds
+	}
+
+	// Set default value for Provider if not provided
+	if obj.Provider == "" {
+		obj.Provider = DefaultCarbonDataProvider
+	}
+
+	// Set default values for the HTTP/JSON provider field mappings if not provided
+	fieldMappings := &obj.HTTPJSONProvider.FieldMappings
+	if fieldMappings.Series == nil {
+		fieldMappings.Series = &DefaultHTTPJSONSeriesField
+	}
+	if fieldMappings.Time == nil {
+		fieldMappings.Time = &DefaultHTTPJSONTimeField
+	}
+	if fieldMappings.CO2 == nil {
+		fieldMappings.CO2 = &DefaultHTTPJSONCO2Field
+	}
+	if fieldMappings.Kwh == nil {
+		fieldMappings.Kwh = &DefaultHTTPJSONKwhField
+	}
+	if fieldMappings.TotalCO2 == nil {
+		fieldMappings.TotalCO2 = &DefaultHTTPJSONTotalCO2Field
+	}
+	if fieldMappings.TotalCost == nil {
+		fieldMappings.TotalCost = &DefaultHTTPJSONTotalCostField
+	}
+	if fieldMappings.TotalKwh == nil {
+		fieldMappings.TotalKwh = &DefaultHTTPJSONTotalKwhField
+	}
+
+	// Set default values for the Prometheus provider if not provided
+	prometheusProvider := &obj.PrometheusProvider
+	if prometheusProvider.EnergyQuery == nil {
+		prometheusProvider.EnergyQuery = &DefaultPrometheusEnergyQuery
+	}
+	if prometheusProvider.StepSeconds == nil {
+		prometheusProvider.StepSeconds = &DefaultPrometheusStepSeconds
+	}
+	if prometheusProvider.RegionLabel == nil {
+		prometheusProvider.RegionLabel = &DefaultPrometheusRegionLabel
+	}
+	if prometheusProvider.DefaultCarbonIntensity == nil {
+		prometheusProvider.DefaultCarbonIntensity = &DefaultCarbonIntensity
+	}
+
+	// Set default values for the carbon deferral if not provided
+	carbonDeferral := &obj.CarbonDeferral
+	if carbonDeferral.CO2Threshold == nil {
+		carbonDeferral.CO2Threshold = &DefaultCarbonDeferralCO2Threshold
+	}
+	if carbonDeferral.CheckPeriodSeconds == nil {
+		carbonDeferral.CheckPeriodSeconds = &DefaultCarbonDeferralCheckPeriodSeconds
+	}
+	if carbonDeferral.DeadlineMarginSeconds == nil {
+		carbonDeferral.DeadlineMarginSeconds = &DefaultCarbonDeferralDeadlineMarginSeconds
+	}
+
+	// Set default values for the carbon ceiling if not provided
+	carbonCeiling := &obj.CarbonCeiling
+	if carbonCeiling.MaxCO2 == nil {
+		carbonCeiling.MaxCO2 = &DefaultCarbonCeilingMaxCO2
+	}
+	if carbonCeiling.MaxKwh == nil {
+		carbonCeiling.MaxKwh = &DefaultCarbonCeilingMaxKwh
+	}
+
+	// Set default values for the embodied carbon term if not provided
+	embodiedCarbon := &obj.EmbodiedCarbon
+	if embodiedCarbon.Weight == nil {
+		embodiedCarbon.Weight = &DefaultEmbodiedCarbonWeight
+	}
+	if embodiedCarbon.LifetimeYears == nil {
+		embodiedCarbon.LifetimeYears = &DefaultEmbodiedCarbonLifetimeYears
+	}
+	if embodiedCarbon.Preference == "" {
+		embodiedCarbon.Preference = DefaultEmbodiedCarbonPreference
+	}
+
+	// Set default value for EntityAggregation if not provided
+	if obj.EntityAggregation == "" {
+		obj.EntityAggregation = DefaultEntityAggregation
+	}
+
+	// Set default value for the consolidation packing weight if not provided
+	if obj.Consolidation.PackingWeight == nil {
+		obj.Consolidation.PackingWeight = &DefaultConsolidationPackingWeight
+	}
+
+	// Set default value for EnableCarbonSchedules if not provided
+	if obj.EnableCarbonSchedules == nil {
+		obj.EnableCarbonSchedules = &DefaultEnableCarbonSchedules
+	}
+
+	// Set default value for ScoreBreakdown if not provided
+	if obj.ScoreBreakdown == "" {
+		obj.ScoreBreakdown = DefaultScoreBreakdown
+	}
 }
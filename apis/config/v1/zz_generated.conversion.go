@@ -40,6 +40,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CarbonCeilingSpec)(nil), (*config.CarbonCeilingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CarbonCeilingSpec_To_config_CarbonCeilingSpec(a.(*CarbonCeilingSpec), b.(*config.CarbonCeilingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CarbonCeilingSpec)(nil), (*CarbonCeilingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CarbonCeilingSpec_To_v1_CarbonCeilingSpec(a.(*config.CarbonCeilingSpec), b.(*CarbonCeilingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CarbonDeferralSpec)(nil), (*config.CarbonDeferralSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CarbonDeferralSpec_To_config_CarbonDeferralSpec(a.(*CarbonDeferralSpec), b.(*config.CarbonDeferralSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CarbonDeferralSpec)(nil), (*CarbonDeferralSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CarbonDeferralSpec_To_v1_CarbonDeferralSpec(a.(*config.CarbonDeferralSpec), b.(*CarbonDeferralSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConsolidationSpec)(nil), (*config.ConsolidationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ConsolidationSpec_To_config_ConsolidationSpec(a.(*ConsolidationSpec), b.(*config.ConsolidationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ConsolidationSpec)(nil), (*ConsolidationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ConsolidationSpec_To_v1_ConsolidationSpec(a.(*config.ConsolidationSpec), b.(*ConsolidationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CoschedulingArgs)(nil), (*config.CoschedulingArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CoschedulingArgs_To_config_CoschedulingArgs(a.(*CoschedulingArgs), b.(*config.CoschedulingArgs), scope)
 	}); err != nil {
@@ -50,6 +80,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EmbodiedCarbonSpec)(nil), (*config.EmbodiedCarbonSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EmbodiedCarbonSpec_To_config_EmbodiedCarbonSpec(a.(*EmbodiedCarbonSpec), b.(*config.EmbodiedCarbonSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EmbodiedCarbonSpec)(nil), (*EmbodiedCarbonSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EmbodiedCarbonSpec_To_v1_EmbodiedCarbonSpec(a.(*config.EmbodiedCarbonSpec), b.(*EmbodiedCarbonSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GreenSchedulingArgs)(nil), (*config.GreenSchedulingArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_GreenSchedulingArgs_To_config_GreenSchedulingArgs(a.(*GreenSchedulingArgs), b.(*config.GreenSchedulingArgs), scope)
 	}); err != nil {
@@ -60,6 +100,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPJSONFieldMappings)(nil), (*config.HTTPJSONFieldMappings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HTTPJSONFieldMappings_To_config_HTTPJSONFieldMappings(a.(*HTTPJSONFieldMappings), b.(*config.HTTPJSONFieldMappings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HTTPJSONFieldMappings)(nil), (*HTTPJSONFieldMappings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HTTPJSONFieldMappings_To_v1_HTTPJSONFieldMappings(a.(*config.HTTPJSONFieldMappings), b.(*HTTPJSONFieldMappings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPJSONProviderSpec)(nil), (*config.HTTPJSONProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HTTPJSONProviderSpec_To_config_HTTPJSONProviderSpec(a.(*HTTPJSONProviderSpec), b.(*config.HTTPJSONProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HTTPJSONProviderSpec)(nil), (*HTTPJSONProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HTTPJSONProviderSpec_To_v1_HTTPJSONProviderSpec(a.(*config.HTTPJSONProviderSpec), b.(*HTTPJSONProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadVariationRiskBalancingArgs)(nil), (*config.LoadVariationRiskBalancingArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_LoadVariationRiskBalancingArgs_To_config_LoadVariationRiskBalancingArgs(a.(*LoadVariationRiskBalancingArgs), b.(*config.LoadVariationRiskBalancingArgs), scope)
 	}); err != nil {
@@ -70,6 +130,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LocationFallbackSpec)(nil), (*config.LocationFallbackSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LocationFallbackSpec_To_config_LocationFallbackSpec(a.(*LocationFallbackSpec), b.(*config.LocationFallbackSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LocationFallbackSpec)(nil), (*LocationFallbackSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LocationFallbackSpec_To_v1_LocationFallbackSpec(a.(*config.LocationFallbackSpec), b.(*LocationFallbackSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LowRiskOverCommitmentArgs)(nil), (*config.LowRiskOverCommitmentArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_LowRiskOverCommitmentArgs_To_config_LowRiskOverCommitmentArgs(a.(*LowRiskOverCommitmentArgs), b.(*config.LowRiskOverCommitmentArgs), scope)
 	}); err != nil {
@@ -130,6 +200,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusProviderSpec)(nil), (*config.PrometheusProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusProviderSpec_To_config_PrometheusProviderSpec(a.(*PrometheusProviderSpec), b.(*config.PrometheusProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrometheusProviderSpec)(nil), (*PrometheusProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrometheusProviderSpec_To_v1_PrometheusProviderSpec(a.(*config.PrometheusProviderSpec), b.(*PrometheusProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ScoringStrategy)(nil), (*config.ScoringStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ScoringStrategy_To_config_ScoringStrategy(a.(*ScoringStrategy), b.(*config.ScoringStrategy), scope)
 	}); err != nil {
@@ -140,6 +220,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretKeyRef)(nil), (*config.SecretKeyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretKeyRef_To_config_SecretKeyRef(a.(*SecretKeyRef), b.(*config.SecretKeyRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecretKeyRef)(nil), (*SecretKeyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecretKeyRef_To_v1_SecretKeyRef(a.(*config.SecretKeyRef), b.(*SecretKeyRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StaticFileProviderSpec)(nil), (*config.StaticFileProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StaticFileProviderSpec_To_config_StaticFileProviderSpec(a.(*StaticFileProviderSpec), b.(*config.StaticFileProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StaticFileProviderSpec)(nil), (*StaticFileProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StaticFileProviderSpec_To_v1_StaticFileProviderSpec(a.(*config.StaticFileProviderSpec), b.(*StaticFileProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SySchedArgs)(nil), (*config.SySchedArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_SySchedArgs_To_config_SySchedArgs(a.(*SySchedArgs), b.(*config.SySchedArgs), scope)
 	}); err != nil {
@@ -193,6 +293,96 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_CarbonCeilingSpec_To_config_CarbonCeilingSpec(in *CarbonCeilingSpec, out *config.CarbonCeilingSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.MaxCO2, &out.MaxCO2, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.MaxKwh, &out.MaxKwh, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CarbonCeilingSpec_To_config_CarbonCeilingSpec is an autogenerated conversion function.
+func Convert_v1_CarbonCeilingSpec_To_config_CarbonCeilingSpec(in *CarbonCeilingSpec, out *config.CarbonCeilingSpec, s conversion.Scope) error {
+	return autoConvert_v1_CarbonCeilingSpec_To_config_CarbonCeilingSpec(in, out, s)
+}
+
+func autoConvert_config_CarbonCeilingSpec_To_v1_CarbonCeilingSpec(in *config.CarbonCeilingSpec, out *CarbonCeilingSpec, s conversion.Scope) error {
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.MaxCO2, &out.MaxCO2, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.MaxKwh, &out.MaxKwh, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CarbonCeilingSpec_To_v1_CarbonCeilingSpec is an autogenerated conversion function.
+func Convert_config_CarbonCeilingSpec_To_v1_CarbonCeilingSpec(in *config.CarbonCeilingSpec, out *CarbonCeilingSpec, s conversion.Scope) error {
+	return autoConvert_config_CarbonCeilingSpec_To_v1_CarbonCeilingSpec(in, out, s)
+}
+
+func autoConvert_v1_CarbonDeferralSpec_To_config_CarbonDeferralSpec(in *CarbonDeferralSpec, out *config.CarbonDeferralSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.CO2Threshold, &out.CO2Threshold, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.CheckPeriodSeconds, &out.CheckPeriodSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.DeadlineMarginSeconds, &out.DeadlineMarginSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CarbonDeferralSpec_To_config_CarbonDeferralSpec is an autogenerated conversion function.
+func Convert_v1_CarbonDeferralSpec_To_config_CarbonDeferralSpec(in *CarbonDeferralSpec, out *config.CarbonDeferralSpec, s conversion.Scope) error {
+	return autoConvert_v1_CarbonDeferralSpec_To_config_CarbonDeferralSpec(in, out, s)
+}
+
+func autoConvert_config_CarbonDeferralSpec_To_v1_CarbonDeferralSpec(in *config.CarbonDeferralSpec, out *CarbonDeferralSpec, s conversion.Scope) error {
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.CO2Threshold, &out.CO2Threshold, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.CheckPeriodSeconds, &out.CheckPeriodSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.DeadlineMarginSeconds, &out.DeadlineMarginSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_CarbonDeferralSpec_To_v1_CarbonDeferralSpec is an autogenerated conversion function.
+func Convert_config_CarbonDeferralSpec_To_v1_CarbonDeferralSpec(in *config.CarbonDeferralSpec, out *CarbonDeferralSpec, s conversion.Scope) error {
+	return autoConvert_config_CarbonDeferralSpec_To_v1_CarbonDeferralSpec(in, out, s)
+}
+
+func autoConvert_v1_ConsolidationSpec_To_config_ConsolidationSpec(in *ConsolidationSpec, out *config.ConsolidationSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.PackingWeight, &out.PackingWeight, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ConsolidationSpec_To_config_ConsolidationSpec is an autogenerated conversion function.
+func Convert_v1_ConsolidationSpec_To_config_ConsolidationSpec(in *ConsolidationSpec, out *config.ConsolidationSpec, s conversion.Scope) error {
+	return autoConvert_v1_ConsolidationSpec_To_config_ConsolidationSpec(in, out, s)
+}
+
+func autoConvert_config_ConsolidationSpec_To_v1_ConsolidationSpec(in *config.ConsolidationSpec, out *ConsolidationSpec, s conversion.Scope) error {
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.PackingWeight, &out.PackingWeight, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ConsolidationSpec_To_v1_ConsolidationSpec is an autogenerated conversion function.
+func Convert_config_ConsolidationSpec_To_v1_ConsolidationSpec(in *config.ConsolidationSpec, out *ConsolidationSpec, s conversion.Scope) error {
+	return autoConvert_config_ConsolidationSpec_To_v1_ConsolidationSpec(in, out, s)
+}
+
 func autoConvert_v1_CoschedulingArgs_To_config_CoschedulingArgs(in *CoschedulingArgs, out *config.CoschedulingArgs, s conversion.Scope) error {
 	if err := metav1.Convert_Pointer_int64_To_int64(&in.PermitWaitingTimeSeconds, &out.PermitWaitingTimeSeconds, s); err != nil {
 		return err
@@ -223,6 +413,40 @@ func Convert_config_CoschedulingArgs_To_v1_CoschedulingArgs(in *config.Coschedul
 	return autoConvert_config_CoschedulingArgs_To_v1_CoschedulingArgs(in, out, s)
 }
 
+func autoConvert_v1_EmbodiedCarbonSpec_To_config_EmbodiedCarbonSpec(in *EmbodiedCarbonSpec, out *config.EmbodiedCarbonSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.Weight, &out.Weight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.LifetimeYears, &out.LifetimeYears, s); err != nil {
+		return err
+	}
+	out.Preference = config.EmbodiedCarbonPreferenceType(in.Preference)
+	out.Footprints = *(*map[string]float64)(unsafe.Pointer(&in.Footprints))
+	return nil
+}
+
+// Convert_v1_EmbodiedCarbonSpec_To_config_EmbodiedCarbonSpec is an autogenerated conversion function.
+func Convert_v1_EmbodiedCarbonSpec_To_config_EmbodiedCarbonSpec(in *EmbodiedCarbonSpec, out *config.EmbodiedCarbonSpec, s conversion.Scope) error {
+	return autoConvert_v1_EmbodiedCarbonSpec_To_config_EmbodiedCarbonSpec(in, out, s)
+}
+
+func autoConvert_config_EmbodiedCarbonSpec_To_v1_EmbodiedCarbonSpec(in *config.EmbodiedCarbonSpec, out *EmbodiedCarbonSpec, s conversion.Scope) error {
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.Weight, &out.Weight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.LifetimeYears, &out.LifetimeYears, s); err != nil {
+		return err
+	}
+	out.Preference = EmbodiedCarbonPreferenceType(in.Preference)
+	out.Footprints = *(*map[string]float64)(unsafe.Pointer(&in.Footprints))
+	return nil
+}
+
+// Convert_config_EmbodiedCarbonSpec_To_v1_EmbodiedCarbonSpec is an autogenerated conversion function.
+func Convert_config_EmbodiedCarbonSpec_To_v1_EmbodiedCarbonSpec(in *config.EmbodiedCarbonSpec, out *EmbodiedCarbonSpec, s conversion.Scope) error {
+	return autoConvert_config_EmbodiedCarbonSpec_To_v1_EmbodiedCarbonSpec(in, out, s)
+}
+
 func autoConvert_v1_GreenSchedulingArgs_To_config_GreenSchedulingArgs(in *GreenSchedulingArgs, out *config.GreenSchedulingArgs, s conversion.Scope) error {
 	if err := metav1.Convert_Pointer_string_To_string(&in.TokenURL, &out.TokenURL, s); err != nil {
 		return err
@@ -233,9 +457,21 @@ func autoConvert_v1_GreenSchedulingArgs_To_config_GreenSchedulingArgs(in *GreenS
 	if err := metav1.Convert_Pointer_string_To_string(&in.ClientSecret, &out.ClientSecret, s); err != nil {
 		return err
 	}
+	if err := Convert_v1_SecretKeyRef_To_config_SecretKeyRef(&in.ClientSecretRef, &out.ClientSecretRef, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.ClientSecretFile, &out.ClientSecretFile, s); err != nil {
+		return err
+	}
 	if err := metav1.Convert_Pointer_string_To_string(&in.SICHostname, &out.SICHostname, s); err != nil {
 		return err
 	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.SICBaseURL, &out.SICBaseURL, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.SICCAFile, &out.SICCAFile, s); err != nil {
+		return err
+	}
 	if err := metav1.Convert_Pointer_float64_To_float64(&in.CO2DecayWeight, &out.CO2DecayWeight, s); err != nil {
 		return err
 	}
@@ -248,6 +484,19 @@ func autoConvert_v1_GreenSchedulingArgs_To_config_GreenSchedulingArgs(in *GreenS
 	if err := metav1.Convert_Pointer_float64_To_float64(&in.DecayRate, &out.DecayRate, s); err != nil {
 		return err
 	}
+	out.DecayModel = config.DecayModelType(in.DecayModel)
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.DecayWindowHours, &out.DecayWindowHours, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.DecayHalfLifeHours, &out.DecayHalfLifeHours, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.EnergyDecayWeight, &out.EnergyDecayWeight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.EnergyWeight, &out.EnergyWeight, s); err != nil {
+		return err
+	}
 	if err := metav1.Convert_Pointer_string_To_string(&in.TimeSeriesInterval, &out.TimeSeriesInterval, s); err != nil {
 		return err
 	}
@@ -257,6 +506,43 @@ func autoConvert_v1_GreenSchedulingArgs_To_config_GreenSchedulingArgs(in *GreenS
 	if err := metav1.Convert_Pointer_string_To_string(&in.SerialNumLabel, &out.SerialNumLabel, s); err != nil {
 		return err
 	}
+	out.ScoreNormalization = config.ScoreNormalizationType(in.ScoreNormalization)
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.ScoreCacheTTLSeconds, &out.ScoreCacheTTLSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.ScoreCacheRefreshPeriodSeconds, &out.ScoreCacheRefreshPeriodSeconds, s); err != nil {
+		return err
+	}
+	out.Provider = config.CarbonDataProviderType(in.Provider)
+	if err := Convert_v1_StaticFileProviderSpec_To_config_StaticFileProviderSpec(&in.StaticFileProvider, &out.StaticFileProvider, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_HTTPJSONProviderSpec_To_config_HTTPJSONProviderSpec(&in.HTTPJSONProvider, &out.HTTPJSONProvider, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_PrometheusProviderSpec_To_config_PrometheusProviderSpec(&in.PrometheusProvider, &out.PrometheusProvider, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CarbonDeferralSpec_To_config_CarbonDeferralSpec(&in.CarbonDeferral, &out.CarbonDeferral, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CarbonCeilingSpec_To_config_CarbonCeilingSpec(&in.CarbonCeiling, &out.CarbonCeiling, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_EmbodiedCarbonSpec_To_config_EmbodiedCarbonSpec(&in.EmbodiedCarbon, &out.EmbodiedCarbon, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_LocationFallbackSpec_To_config_LocationFallbackSpec(&in.LocationFallback, &out.LocationFallback, s); err != nil {
+		return err
+	}
+	out.EntityAggregation = config.EntityAggregationType(in.EntityAggregation)
+	if err := Convert_v1_ConsolidationSpec_To_config_ConsolidationSpec(&in.Consolidation, &out.Consolidation, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.EnableCarbonSchedules, &out.EnableCarbonSchedules, s); err != nil {
+		return err
+	}
+	out.ScoreBreakdown = config.ScoreBreakdownType(in.ScoreBreakdown)
 	return nil
 }
 
@@ -275,9 +561,21 @@ func autoConvert_config_GreenSchedulingArgs_To_v1_GreenSchedulingArgs(in *config
 	if err := metav1.Convert_string_To_Pointer_string(&in.ClientSecret, &out.ClientSecret, s); err != nil {
 		return err
 	}
+	if err := Convert_config_SecretKeyRef_To_v1_SecretKeyRef(&in.ClientSecretRef, &out.ClientSecretRef, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.ClientSecretFile, &out.ClientSecretFile, s); err != nil {
+		return err
+	}
 	if err := metav1.Convert_string_To_Pointer_string(&in.SICHostname, &out.SICHostname, s); err != nil {
 		return err
 	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.SICBaseURL, &out.SICBaseURL, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.SICCAFile, &out.SICCAFile, s); err != nil {
+		return err
+	}
 	if err := metav1.Convert_float64_To_Pointer_float64(&in.CO2DecayWeight, &out.CO2DecayWeight, s); err != nil {
 		return err
 	}
@@ -290,6 +588,19 @@ func autoConvert_config_GreenSchedulingArgs_To_v1_GreenSchedulingArgs(in *config
 	if err := metav1.Convert_float64_To_Pointer_float64(&in.DecayRate, &out.DecayRate, s); err != nil {
 		return err
 	}
+	out.DecayModel = DecayModelType(in.DecayModel)
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.DecayWindowHours, &out.DecayWindowHours, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.DecayHalfLifeHours, &out.DecayHalfLifeHours, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.EnergyDecayWeight, &out.EnergyDecayWeight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.EnergyWeight, &out.EnergyWeight, s); err != nil {
+		return err
+	}
 	if err := metav1.Convert_string_To_Pointer_string(&in.TimeSeriesInterval, &out.TimeSeriesInterval, s); err != nil {
 		return err
 	}
@@ -299,6 +610,43 @@ func autoConvert_config_GreenSchedulingArgs_To_v1_GreenSchedulingArgs(in *config
 	if err := metav1.Convert_string_To_Pointer_string(&in.SerialNumLabel, &out.SerialNumLabel, s); err != nil {
 		return err
 	}
+	out.ScoreNormalization = ScoreNormalizationType(in.ScoreNormalization)
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.ScoreCacheTTLSeconds, &out.ScoreCacheTTLSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.ScoreCacheRefreshPeriodSeconds, &out.ScoreCacheRefreshPeriodSeconds, s); err != nil {
+		return err
+	}
+	out.Provider = CarbonDataProviderType(in.Provider)
+	if err := Convert_config_StaticFileProviderSpec_To_v1_StaticFileProviderSpec(&in.StaticFileProvider, &out.StaticFileProvider, s); err != nil {
+		return err
+	}
+	if err := Convert_config_HTTPJSONProviderSpec_To_v1_HTTPJSONProviderSpec(&in.HTTPJSONProvider, &out.HTTPJSONProvider, s); err != nil {
+		return err
+	}
+	if err := Convert_config_PrometheusProviderSpec_To_v1_PrometheusProviderSpec(&in.PrometheusProvider, &out.PrometheusProvider, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CarbonDeferralSpec_To_v1_CarbonDeferralSpec(&in.CarbonDeferral, &out.CarbonDeferral, s); err != nil {
+		return err
+	}
+	if err := Convert_config_CarbonCeilingSpec_To_v1_CarbonCeilingSpec(&in.CarbonCeiling, &out.CarbonCeiling, s); err != nil {
+		return err
+	}
+	if err := Convert_config_EmbodiedCarbonSpec_To_v1_EmbodiedCarbonSpec(&in.EmbodiedCarbon, &out.EmbodiedCarbon, s); err != nil {
+		return err
+	}
+	if err := Convert_config_LocationFallbackSpec_To_v1_LocationFallbackSpec(&in.LocationFallback, &out.LocationFallback, s); err != nil {
+		return err
+	}
+	out.EntityAggregation = EntityAggregationType(in.EntityAggregation)
+	if err := Convert_config_ConsolidationSpec_To_v1_ConsolidationSpec(&in.Consolidation, &out.Consolidation, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.EnableCarbonSchedules, &out.EnableCarbonSchedules, s); err != nil {
+		return err
+	}
+	out.ScoreBreakdown = ScoreBreakdownType(in.ScoreBreakdown)
 	return nil
 }
 
@@ -307,6 +655,102 @@ func Convert_config_GreenSchedulingArgs_To_v1_GreenSchedulingArgs(in *config.Gre
 	return autoConvert_config_GreenSchedulingArgs_To_v1_GreenSchedulingArgs(in, out, s)
 }
 
+func autoConvert_v1_HTTPJSONFieldMappings_To_config_HTTPJSONFieldMappings(in *HTTPJSONFieldMappings, out *config.HTTPJSONFieldMappings, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.Series, &out.Series, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Time, &out.Time, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.CO2, &out.CO2, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Kwh, &out.Kwh, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.TotalCO2, &out.TotalCO2, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.TotalCost, &out.TotalCost, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.TotalKwh, &out.TotalKwh, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_HTTPJSONFieldMappings_To_config_HTTPJSONFieldMappings is an autogenerated conversion function.
+func Convert_v1_HTTPJSONFieldMappings_To_config_HTTPJSONFieldMappings(in *HTTPJSONFieldMappings, out *config.HTTPJSONFieldMappings, s conversion.Scope) error {
+	return autoConvert_v1_HTTPJSONFieldMappings_To_config_HTTPJSONFieldMappings(in, out, s)
+}
+
+func autoConvert_config_HTTPJSONFieldMappings_To_v1_HTTPJSONFieldMappings(in *config.HTTPJSONFieldMappings, out *HTTPJSONFieldMappings, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.Series, &out.Series, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Time, &out.Time, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.CO2, &out.CO2, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Kwh, &out.Kwh, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.TotalCO2, &out.TotalCO2, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.TotalCost, &out.TotalCost, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.TotalKwh, &out.TotalKwh, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_HTTPJSONFieldMappings_To_v1_HTTPJSONFieldMappings is an autogenerated conversion function.
+func Convert_config_HTTPJSONFieldMappings_To_v1_HTTPJSONFieldMappings(in *config.HTTPJSONFieldMappings, out *HTTPJSONFieldMappings, s conversion.Scope) error {
+	return autoConvert_config_HTTPJSONFieldMappings_To_v1_HTTPJSONFieldMappings(in, out, s)
+}
+
+func autoConvert_v1_HTTPJSONProviderSpec_To_config_HTTPJSONProviderSpec(in *HTTPJSONProviderSpec, out *config.HTTPJSONProviderSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.URL, &out.URL, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Token, &out.Token, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_HTTPJSONFieldMappings_To_config_HTTPJSONFieldMappings(&in.FieldMappings, &out.FieldMappings, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_HTTPJSONProviderSpec_To_config_HTTPJSONProviderSpec is an autogenerated conversion function.
+func Convert_v1_HTTPJSONProviderSpec_To_config_HTTPJSONProviderSpec(in *HTTPJSONProviderSpec, out *config.HTTPJSONProviderSpec, s conversion.Scope) error {
+	return autoConvert_v1_HTTPJSONProviderSpec_To_config_HTTPJSONProviderSpec(in, out, s)
+}
+
+func autoConvert_config_HTTPJSONProviderSpec_To_v1_HTTPJSONProviderSpec(in *config.HTTPJSONProviderSpec, out *HTTPJSONProviderSpec, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.URL, &out.URL, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Token, &out.Token, s); err != nil {
+		return err
+	}
+	if err := Convert_config_HTTPJSONFieldMappings_To_v1_HTTPJSONFieldMappings(&in.FieldMappings, &out.FieldMappings, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_HTTPJSONProviderSpec_To_v1_HTTPJSONProviderSpec is an autogenerated conversion function.
+func Convert_config_HTTPJSONProviderSpec_To_v1_HTTPJSONProviderSpec(in *config.HTTPJSONProviderSpec, out *HTTPJSONProviderSpec, s conversion.Scope) error {
+	return autoConvert_config_HTTPJSONProviderSpec_To_v1_HTTPJSONProviderSpec(in, out, s)
+}
+
 func autoConvert_v1_LoadVariationRiskBalancingArgs_To_config_LoadVariationRiskBalancingArgs(in *LoadVariationRiskBalancingArgs, out *config.LoadVariationRiskBalancingArgs, s conversion.Scope) error {
 	if err := Convert_v1_TrimaranSpec_To_config_TrimaranSpec(&in.TrimaranSpec, &out.TrimaranSpec, s); err != nil {
 		return err
@@ -343,6 +787,38 @@ func Convert_config_LoadVariationRiskBalancingArgs_To_v1_LoadVariationRiskBalanc
 	return autoConvert_config_LoadVariationRiskBalancingArgs_To_v1_LoadVariationRiskBalancingArgs(in, out, s)
 }
 
+func autoConvert_v1_LocationFallbackSpec_To_config_LocationFallbackSpec(in *LocationFallbackSpec, out *config.LocationFallbackSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.ZoneFilterKey, &out.ZoneFilterKey, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.RegionFilterKey, &out.RegionFilterKey, s); err != nil {
+		return err
+	}
+	out.Locations = *(*map[string]string)(unsafe.Pointer(&in.Locations))
+	return nil
+}
+
+// Convert_v1_LocationFallbackSpec_To_config_LocationFallbackSpec is an autogenerated conversion function.
+func Convert_v1_LocationFallbackSpec_To_config_LocationFallbackSpec(in *LocationFallbackSpec, out *config.LocationFallbackSpec, s conversion.Scope) error {
+	return autoConvert_v1_LocationFallbackSpec_To_config_LocationFallbackSpec(in, out, s)
+}
+
+func autoConvert_config_LocationFallbackSpec_To_v1_LocationFallbackSpec(in *config.LocationFallbackSpec, out *LocationFallbackSpec, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.ZoneFilterKey, &out.ZoneFilterKey, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.RegionFilterKey, &out.RegionFilterKey, s); err != nil {
+		return err
+	}
+	out.Locations = *(*map[string]string)(unsafe.Pointer(&in.Locations))
+	return nil
+}
+
+// Convert_config_LocationFallbackSpec_To_v1_LocationFallbackSpec is an autogenerated conversion function.
+func Convert_config_LocationFallbackSpec_To_v1_LocationFallbackSpec(in *config.LocationFallbackSpec, out *LocationFallbackSpec, s conversion.Scope) error {
+	return autoConvert_config_LocationFallbackSpec_To_v1_LocationFallbackSpec(in, out, s)
+}
+
 func autoConvert_v1_LowRiskOverCommitmentArgs_To_config_LowRiskOverCommitmentArgs(in *LowRiskOverCommitmentArgs, out *config.LowRiskOverCommitmentArgs, s conversion.Scope) error {
 	if err := Convert_v1_TrimaranSpec_To_config_TrimaranSpec(&in.TrimaranSpec, &out.TrimaranSpec, s); err != nil {
 		return err
@@ -543,6 +1019,62 @@ func Convert_config_PreemptionTolerationArgs_To_v1_PreemptionTolerationArgs(in *
 	return autoConvert_config_PreemptionTolerationArgs_To_v1_PreemptionTolerationArgs(in, out, s)
 }
 
+func autoConvert_v1_PrometheusProviderSpec_To_config_PrometheusProviderSpec(in *PrometheusProviderSpec, out *config.PrometheusProviderSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.Address, &out.Address, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Token, &out.Token, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.EnergyQuery, &out.EnergyQuery, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.StepSeconds, &out.StepSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.RegionLabel, &out.RegionLabel, s); err != nil {
+		return err
+	}
+	out.CarbonIntensity = *(*map[string]float64)(unsafe.Pointer(&in.CarbonIntensity))
+	if err := metav1.Convert_Pointer_float64_To_float64(&in.DefaultCarbonIntensity, &out.DefaultCarbonIntensity, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_PrometheusProviderSpec_To_config_PrometheusProviderSpec is an autogenerated conversion function.
+func Convert_v1_PrometheusProviderSpec_To_config_PrometheusProviderSpec(in *PrometheusProviderSpec, out *config.PrometheusProviderSpec, s conversion.Scope) error {
+	return autoConvert_v1_PrometheusProviderSpec_To_config_PrometheusProviderSpec(in, out, s)
+}
+
+func autoConvert_config_PrometheusProviderSpec_To_v1_PrometheusProviderSpec(in *config.PrometheusProviderSpec, out *PrometheusProviderSpec, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.Address, &out.Address, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Token, &out.Token, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.EnergyQuery, &out.EnergyQuery, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.StepSeconds, &out.StepSeconds, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.RegionLabel, &out.RegionLabel, s); err != nil {
+		return err
+	}
+	out.CarbonIntensity = *(*map[string]float64)(unsafe.Pointer(&in.CarbonIntensity))
+	if err := metav1.Convert_float64_To_Pointer_float64(&in.DefaultCarbonIntensity, &out.DefaultCarbonIntensity, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_PrometheusProviderSpec_To_v1_PrometheusProviderSpec is an autogenerated conversion function.
+func Convert_config_PrometheusProviderSpec_To_v1_PrometheusProviderSpec(in *config.PrometheusProviderSpec, out *PrometheusProviderSpec, s conversion.Scope) error {
+	return autoConvert_config_PrometheusProviderSpec_To_v1_PrometheusProviderSpec(in, out, s)
+}
+
 func autoConvert_v1_ScoringStrategy_To_config_ScoringStrategy(in *ScoringStrategy, out *config.ScoringStrategy, s conversion.Scope) error {
 	out.Type = config.ScoringStrategyType(in.Type)
 	out.Resources = *(*[]apisconfig.ResourceSpec)(unsafe.Pointer(&in.Resources))
@@ -565,6 +1097,66 @@ func Convert_config_ScoringStrategy_To_v1_ScoringStrategy(in *config.ScoringStra
 	return autoConvert_config_ScoringStrategy_To_v1_ScoringStrategy(in, out, s)
 }
 
+func autoConvert_v1_SecretKeyRef_To_config_SecretKeyRef(in *SecretKeyRef, out *config.SecretKeyRef, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.Namespace, &out.Namespace, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Name, &out.Name, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.Key, &out.Key, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SecretKeyRef_To_config_SecretKeyRef is an autogenerated conversion function.
+func Convert_v1_SecretKeyRef_To_config_SecretKeyRef(in *SecretKeyRef, out *config.SecretKeyRef, s conversion.Scope) error {
+	return autoConvert_v1_SecretKeyRef_To_config_SecretKeyRef(in, out, s)
+}
+
+func autoConvert_config_SecretKeyRef_To_v1_SecretKeyRef(in *config.SecretKeyRef, out *SecretKeyRef, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.Namespace, &out.Namespace, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Name, &out.Name, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.Key, &out.Key, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_SecretKeyRef_To_v1_SecretKeyRef is an autogenerated conversion function.
+func Convert_config_SecretKeyRef_To_v1_SecretKeyRef(in *config.SecretKeyRef, out *SecretKeyRef, s conversion.Scope) error {
+	return autoConvert_config_SecretKeyRef_To_v1_SecretKeyRef(in, out, s)
+}
+
+func autoConvert_v1_StaticFileProviderSpec_To_config_StaticFileProviderSpec(in *StaticFileProviderSpec, out *config.StaticFileProviderSpec, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_string_To_string(&in.Path, &out.Path, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_StaticFileProviderSpec_To_config_StaticFileProviderSpec is an autogenerated conversion function.
+func Convert_v1_StaticFileProviderSpec_To_config_StaticFileProviderSpec(in *StaticFileProviderSpec, out *config.StaticFileProviderSpec, s conversion.Scope) error {
+	return autoConvert_v1_StaticFileProviderSpec_To_config_StaticFileProviderSpec(in, out, s)
+}
+
+func autoConvert_config_StaticFileProviderSpec_To_v1_StaticFileProviderSpec(in *config.StaticFileProviderSpec, out *StaticFileProviderSpec, s conversion.Scope) error {
+	if err := metav1.Convert_string_To_Pointer_string(&in.Path, &out.Path, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_StaticFileProviderSpec_To_v1_StaticFileProviderSpec is an autogenerated conversion function.
+func Convert_config_StaticFileProviderSpec_To_v1_StaticFileProviderSpec(in *config.StaticFileProviderSpec, out *StaticFileProviderSpec, s conversion.Scope) error {
+	return autoConvert_config_StaticFileProviderSpec_To_v1_StaticFileProviderSpec(in, out, s)
+}
+
 func autoConvert_v1_SySchedArgs_To_config_SySchedArgs(in *SySchedArgs, out *config.SySchedArgs, s conversion.Scope) error {
 	if err := metav1.Convert_Pointer_string_To_string(&in.DefaultProfileNamespace, &out.DefaultProfileNamespace, s); err != nil {
 		return err
@@ -27,6 +27,84 @@ import (
 	configv1 "k8s.io/kube-scheduler/config/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonCeilingSpec) DeepCopyInto(out *CarbonCeilingSpec) {
+	*out = *in
+	if in.MaxCO2 != nil {
+		in, out := &in.MaxCO2, &out.MaxCO2
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxKwh != nil {
+		in, out := &in.MaxKwh, &out.MaxKwh
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonCeilingSpec.
+func (in *CarbonCeilingSpec) DeepCopy() *CarbonCeilingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonCeilingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonDeferralSpec) DeepCopyInto(out *CarbonDeferralSpec) {
+	*out = *in
+	if in.CO2Threshold != nil {
+		in, out := &in.CO2Threshold, &out.CO2Threshold
+		*out = new(float64)
+		**out = **in
+	}
+	if in.CheckPeriodSeconds != nil {
+		in, out := &in.CheckPeriodSeconds, &out.CheckPeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeadlineMarginSeconds != nil {
+		in, out := &in.DeadlineMarginSeconds, &out.DeadlineMarginSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonDeferralSpec.
+func (in *CarbonDeferralSpec) DeepCopy() *CarbonDeferralSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonDeferralSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsolidationSpec) DeepCopyInto(out *ConsolidationSpec) {
+	*out = *in
+	if in.PackingWeight != nil {
+		in, out := &in.PackingWeight, &out.PackingWeight
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsolidationSpec.
+func (in *ConsolidationSpec) DeepCopy() *ConsolidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsolidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoschedulingArgs) DeepCopyInto(out *CoschedulingArgs) {
 	*out = *in
@@ -62,6 +140,39 @@ func (in *CoschedulingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmbodiedCarbonSpec) DeepCopyInto(out *EmbodiedCarbonSpec) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(float64)
+		**out = **in
+	}
+	if in.LifetimeYears != nil {
+		in, out := &in.LifetimeYears, &out.LifetimeYears
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Footprints != nil {
+		in, out := &in.Footprints, &out.Footprints
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmbodiedCarbonSpec.
+func (in *EmbodiedCarbonSpec) DeepCopy() *EmbodiedCarbonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EmbodiedCarbonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GreenSchedulingArgs) DeepCopyInto(out *GreenSchedulingArgs) {
 	*out = *in
@@ -81,11 +192,27 @@ func (in *GreenSchedulingArgs) DeepCopyInto(out *GreenSchedulingArgs) {
 		*out = new(string)
 		**out = **in
 	}
+	in.ClientSecretRef.DeepCopyInto(&out.ClientSecretRef)
+	if in.ClientSecretFile != nil {
+		in, out := &in.ClientSecretFile, &out.ClientSecretFile
+		*out = new(string)
+		**out = **in
+	}
 	if in.SICHostname != nil {
 		in, out := &in.SICHostname, &out.SICHostname
 		*out = new(string)
 		**out = **in
 	}
+	if in.SICBaseURL != nil {
+		in, out := &in.SICBaseURL, &out.SICBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.SICCAFile != nil {
+		in, out := &in.SICCAFile, &out.SICCAFile
+		*out = new(string)
+		**out = **in
+	}
 	if in.CO2DecayWeight != nil {
 		in, out := &in.CO2DecayWeight, &out.CO2DecayWeight
 		*out = new(float64)
@@ -106,6 +233,26 @@ func (in *GreenSchedulingArgs) DeepCopyInto(out *GreenSchedulingArgs) {
 		*out = new(float64)
 		**out = **in
 	}
+	if in.DecayWindowHours != nil {
+		in, out := &in.DecayWindowHours, &out.DecayWindowHours
+		*out = new(float64)
+		**out = **in
+	}
+	if in.DecayHalfLifeHours != nil {
+		in, out := &in.DecayHalfLifeHours, &out.DecayHalfLifeHours
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EnergyDecayWeight != nil {
+		in, out := &in.EnergyDecayWeight, &out.EnergyDecayWeight
+		*out = new(float64)
+		**out = **in
+	}
+	if in.EnergyWeight != nil {
+		in, out := &in.EnergyWeight, &out.EnergyWeight
+		*out = new(float64)
+		**out = **in
+	}
 	if in.TimeSeriesInterval != nil {
 		in, out := &in.TimeSeriesInterval, &out.TimeSeriesInterval
 		*out = new(string)
@@ -121,6 +268,29 @@ func (in *GreenSchedulingArgs) DeepCopyInto(out *GreenSchedulingArgs) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScoreCacheTTLSeconds != nil {
+		in, out := &in.ScoreCacheTTLSeconds, &out.ScoreCacheTTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScoreCacheRefreshPeriodSeconds != nil {
+		in, out := &in.ScoreCacheRefreshPeriodSeconds, &out.ScoreCacheRefreshPeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.StaticFileProvider.DeepCopyInto(&out.StaticFileProvider)
+	in.HTTPJSONProvider.DeepCopyInto(&out.HTTPJSONProvider)
+	in.PrometheusProvider.DeepCopyInto(&out.PrometheusProvider)
+	in.CarbonDeferral.DeepCopyInto(&out.CarbonDeferral)
+	in.CarbonCeiling.DeepCopyInto(&out.CarbonCeiling)
+	in.EmbodiedCarbon.DeepCopyInto(&out.EmbodiedCarbon)
+	in.LocationFallback.DeepCopyInto(&out.LocationFallback)
+	in.Consolidation.DeepCopyInto(&out.Consolidation)
+	if in.EnableCarbonSchedules != nil {
+		in, out := &in.EnableCarbonSchedules, &out.EnableCarbonSchedules
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -142,6 +312,84 @@ func (in *GreenSchedulingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJSONFieldMappings) DeepCopyInto(out *HTTPJSONFieldMappings) {
+	*out = *in
+	if in.Series != nil {
+		in, out := &in.Series, &out.Series
+		*out = new(string)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(string)
+		**out = **in
+	}
+	if in.CO2 != nil {
+		in, out := &in.CO2, &out.CO2
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kwh != nil {
+		in, out := &in.Kwh, &out.Kwh
+		*out = new(string)
+		**out = **in
+	}
+	if in.TotalCO2 != nil {
+		in, out := &in.TotalCO2, &out.TotalCO2
+		*out = new(string)
+		**out = **in
+	}
+	if in.TotalCost != nil {
+		in, out := &in.TotalCost, &out.TotalCost
+		*out = new(string)
+		**out = **in
+	}
+	if in.TotalKwh != nil {
+		in, out := &in.TotalKwh, &out.TotalKwh
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJSONFieldMappings.
+func (in *HTTPJSONFieldMappings) DeepCopy() *HTTPJSONFieldMappings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJSONFieldMappings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJSONProviderSpec) DeepCopyInto(out *HTTPJSONProviderSpec) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(string)
+		**out = **in
+	}
+	in.FieldMappings.DeepCopyInto(&out.FieldMappings)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJSONProviderSpec.
+func (in *HTTPJSONProviderSpec) DeepCopy() *HTTPJSONProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJSONProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadVariationRiskBalancingArgs) DeepCopyInto(out *LoadVariationRiskBalancingArgs) {
 	*out = *in
@@ -178,6 +426,39 @@ func (in *LoadVariationRiskBalancingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationFallbackSpec) DeepCopyInto(out *LocationFallbackSpec) {
+	*out = *in
+	if in.ZoneFilterKey != nil {
+		in, out := &in.ZoneFilterKey, &out.ZoneFilterKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegionFilterKey != nil {
+		in, out := &in.RegionFilterKey, &out.RegionFilterKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationFallbackSpec.
+func (in *LocationFallbackSpec) DeepCopy() *LocationFallbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationFallbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LowRiskOverCommitmentArgs) DeepCopyInto(out *LowRiskOverCommitmentArgs) {
 	*out = *in
@@ -428,6 +709,59 @@ func (in *PreemptionTolerationArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusProviderSpec) DeepCopyInto(out *PrometheusProviderSpec) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnergyQuery != nil {
+		in, out := &in.EnergyQuery, &out.EnergyQuery
+		*out = new(string)
+		**out = **in
+	}
+	if in.StepSeconds != nil {
+		in, out := &in.StepSeconds, &out.StepSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RegionLabel != nil {
+		in, out := &in.RegionLabel, &out.RegionLabel
+		*out = new(string)
+		**out = **in
+	}
+	if in.CarbonIntensity != nil {
+		in, out := &in.CarbonIntensity, &out.CarbonIntensity
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefaultCarbonIntensity != nil {
+		in, out := &in.DefaultCarbonIntensity, &out.DefaultCarbonIntensity
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusProviderSpec.
+func (in *PrometheusProviderSpec) DeepCopy() *PrometheusProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScoringStrategy) DeepCopyInto(out *ScoringStrategy) {
 	*out = *in
@@ -449,6 +783,58 @@ func (in *ScoringStrategy) DeepCopy() *ScoringStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticFileProviderSpec) DeepCopyInto(out *StaticFileProviderSpec) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticFileProviderSpec.
+func (in *StaticFileProviderSpec) DeepCopy() *StaticFileProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticFileProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SySchedArgs) DeepCopyInto(out *SySchedArgs) {
 	*out = *in
@@ -17,7 +17,12 @@ limitations under the License.
 package validation
 
 import (
+	"net/url"
+	"regexp"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
@@ -46,3 +51,302 @@ func validateScoringStrategyType(scoringStrategy config.ScoringStrategyType, pat
 	}
 	return nil
 }
+
+var (
+	validDecayModels = sets.NewString(
+		string(config.LogisticDecayModel),
+		string(config.ExponentialDecayModel),
+		string(config.LinearDecayModel),
+		string(config.SlidingWindowDecayModel),
+		string(config.EWMADecayModel),
+	)
+	validScoreNormalizations = sets.NewString(
+		string(config.MaxRatioScoreNormalization),
+		string(config.MinMaxScoreNormalization),
+		string(config.RankScoreNormalization),
+		string(config.ZScoreScoreNormalization),
+	)
+	validEmbodiedCarbonPreferences = sets.NewString(
+		string(config.AmortizedEmbodiedCarbonPreference),
+		string(config.NewerEmbodiedCarbonPreference),
+	)
+	validCarbonDataProviders = sets.NewString(
+		string(config.SICCarbonDataProvider),
+		string(config.StaticFileCarbonDataProvider),
+		string(config.HTTPJSONCarbonDataProvider),
+		string(config.PrometheusCarbonDataProvider),
+		string(config.NodeSustainabilityCarbonDataProvider),
+	)
+	validEntityAggregations = sets.NewString(
+		string(config.SumEntityAggregation),
+		string(config.AverageEntityAggregation),
+	)
+	validScoreBreakdowns = sets.NewString(
+		string(config.DisabledScoreBreakdown),
+		string(config.OptInScoreBreakdown),
+		string(config.AlwaysScoreBreakdown),
+	)
+	// validLocationFilterKeys are the SIC filter keys nodes can be matched with locations by.
+	validLocationFilterKeys = sets.NewString(
+		"locationName",
+		"locationId",
+		"locationCity",
+		"locationState",
+		"locationCountry",
+	)
+	// timeSeriesIntervalPattern matches the intervals SIC aggregates usage series by, e.g. "1 day".
+	timeSeriesIntervalPattern = regexp.MustCompile(`^[1-9][0-9]* (hour|day|week|month)s?$`)
+)
+
+// ValidateGreenSchedulingArgs validates the GreenScheduling args, including those of the
+// configured carbon data provider.
+func ValidateGreenSchedulingArgs(path *field.Path, args *config.GreenSchedulingArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	serialNumLabelPath := path.Child("serialNumLabel")
+	if args.SerialNumLabel == "" {
+		allErrs = append(allErrs, field.Required(serialNumLabelPath, "the label holding the serial number of nodes is required"))
+	} else {
+		for _, msg := range validation.IsQualifiedName(args.SerialNumLabel) {
+			allErrs = append(allErrs, field.Invalid(serialNumLabelPath, args.SerialNumLabel, msg))
+		}
+	}
+
+	allErrs = append(allErrs, validateCarbonDataProvider(path, args)...)
+	allErrs = append(allErrs, ValidateGreenSchedulingScoringArgs(path, args)...)
+
+	if args.ConsiderationDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("considerationDays"), args.ConsiderationDays, "must be greater than 0"))
+	}
+	if args.ScoreCacheTTLSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("scoreCacheTTLSeconds"), args.ScoreCacheTTLSeconds, "must be greater than 0"))
+	}
+	if args.ScoreCacheRefreshPeriodSeconds <= 0 || args.ScoreCacheRefreshPeriodSeconds > args.ScoreCacheTTLSeconds {
+		allErrs = append(allErrs, field.Invalid(path.Child("scoreCacheRefreshPeriodSeconds"), args.ScoreCacheRefreshPeriodSeconds, "must be greater than 0 and at most scoreCacheTTLSeconds"))
+	}
+
+	deferral, deferralPath := args.CarbonDeferral, path.Child("carbonDeferral")
+	if deferral.CO2Threshold < 0 {
+		allErrs = append(allErrs, field.Invalid(deferralPath.Child("co2Threshold"), deferral.CO2Threshold, "must be greater than or equal to 0"))
+	}
+	if deferral.CO2Threshold > 0 && deferral.CheckPeriodSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(deferralPath.Child("checkPeriodSeconds"), deferral.CheckPeriodSeconds, "must be greater than 0 when deferral is enabled"))
+	}
+	if deferral.CO2Threshold > 0 && deferral.DeadlineMarginSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(deferralPath.Child("deadlineMarginSeconds"), deferral.DeadlineMarginSeconds, "must be greater than or equal to 0"))
+	}
+
+	ceilingPath := path.Child("carbonCeiling")
+	if args.CarbonCeiling.MaxCO2 < 0 {
+		allErrs = append(allErrs, field.Invalid(ceilingPath.Child("maxCO2"), args.CarbonCeiling.MaxCO2, "must be greater than or equal to 0"))
+	}
+	if args.CarbonCeiling.MaxKwh < 0 {
+		allErrs = append(allErrs, field.Invalid(ceilingPath.Child("maxKwh"), args.CarbonCeiling.MaxKwh, "must be greater than or equal to 0"))
+	}
+
+	if packingWeight := args.Consolidation.PackingWeight; packingWeight < 0 || packingWeight > 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("consolidation", "packingWeight"), packingWeight, "must be between 0 and 1"))
+	}
+	if !validScoreBreakdowns.Has(string(args.ScoreBreakdown)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("scoreBreakdown"), args.ScoreBreakdown, validScoreBreakdowns.List()))
+	}
+
+	allErrs = append(allErrs, validateLocationFallback(path.Child("locationFallback"), args)...)
+	return allErrs
+}
+
+// ValidateGreenSchedulingScoringArgs validates the GreenScheduling args sustainability scores
+// are calculated with: the weights, the decay model, the score normalization and the embodied
+// carbon term.
+func ValidateGreenSchedulingScoringArgs(path *field.Path, args *config.GreenSchedulingArgs) field.ErrorList {
+	allErrs := ValidateGreenSchedulingWeights(path, args)
+
+	decayModelPath := path.Child("decayModel")
+	switch {
+	case !validDecayModels.Has(string(args.DecayModel)):
+		allErrs = append(allErrs, field.NotSupported(decayModelPath, args.DecayModel, validDecayModels.List()))
+	case (args.DecayModel == config.LinearDecayModel || args.DecayModel == config.SlidingWindowDecayModel) && args.DecayWindowHours <= 0:
+		allErrs = append(allErrs, field.Invalid(path.Child("decayWindowHours"), args.DecayWindowHours, "must be greater than 0 with the "+string(args.DecayModel)+" decay model"))
+	case args.DecayModel == config.EWMADecayModel && args.DecayHalfLifeHours <= 0:
+		allErrs = append(allErrs, field.Invalid(path.Child("decayHalfLifeHours"), args.DecayHalfLifeHours, "must be greater than 0 with the EWMA decay model"))
+	}
+	if !validScoreNormalizations.Has(string(args.ScoreNormalization)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("scoreNormalization"), args.ScoreNormalization, validScoreNormalizations.List()))
+	}
+
+	embodiedCarbon, embodiedCarbonPath := args.EmbodiedCarbon, path.Child("embodiedCarbon")
+	if embodiedCarbon.Weight < 0 {
+		allErrs = append(allErrs, field.Invalid(embodiedCarbonPath.Child("weight"), embodiedCarbon.Weight, "must be greater than or equal to 0"))
+	}
+	if embodiedCarbon.LifetimeYears <= 0 {
+		allErrs = append(allErrs, field.Invalid(embodiedCarbonPath.Child("lifetimeYears"), embodiedCarbon.LifetimeYears, "must be greater than 0"))
+	}
+	if !validEmbodiedCarbonPreferences.Has(string(embodiedCarbon.Preference)) {
+		allErrs = append(allErrs, field.NotSupported(embodiedCarbonPath.Child("preference"), embodiedCarbon.Preference, validEmbodiedCarbonPreferences.List()))
+	}
+	for model, footprint := range embodiedCarbon.Footprints {
+		footprintPath := embodiedCarbonPath.Child("footprints").Key(model)
+		if !strings.Contains(model, "/") {
+			allErrs = append(allErrs, field.Invalid(footprintPath, model, "must be keyed by <make>/<model>"))
+		}
+		if footprint < 0 {
+			allErrs = append(allErrs, field.Invalid(footprintPath, footprint, "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateGreenSchedulingWeights validates the sustainability weights and the decay rate of the
+// GreenScheduling args, also applying to the weights overridden by namespaces and pods. At least
+// one weight must be positive for scores to differ between nodes.
+func ValidateGreenSchedulingWeights(path *field.Path, args *config.GreenSchedulingArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	weights := []struct {
+		name  string
+		value float64
+	}{
+		{"co2DecayWeight", args.CO2DecayWeight},
+		{"totalCO2Weight", args.TotalCO2Weight},
+		{"costWeight", args.CostWeight},
+		{"energyDecayWeight", args.EnergyDecayWeight},
+		{"energyWeight", args.EnergyWeight},
+	}
+	positive := args.EmbodiedCarbon.Weight > 0
+	for _, weight := range weights {
+		if weight.value < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child(weight.name), weight.value, "must be greater than or equal to 0"))
+		}
+		positive = positive || weight.value > 0
+	}
+	if !positive {
+		allErrs = append(allErrs, field.Required(path, "at least one of co2DecayWeight, totalCO2Weight, costWeight, energyDecayWeight, energyWeight or embodiedCarbon.weight must be greater than 0"))
+	}
+	if args.DecayRate < 0 || args.DecayRate > 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("decayRate"), args.DecayRate, "must be between 0 and 1"))
+	}
+	return allErrs
+}
+
+// validateCarbonDataProvider validates the args of the configured carbon data provider.
+func validateCarbonDataProvider(path *field.Path, args *config.GreenSchedulingArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	switch args.Provider {
+	case config.SICCarbonDataProvider:
+		allErrs = append(allErrs, validateRequiredURL(path.Child("tokenUrl"), args.TokenURL)...)
+		if args.ClientID == "" {
+			allErrs = append(allErrs, field.Required(path.Child("clientId"), ""))
+		}
+		allErrs = append(allErrs, validateClientSecret(path, args)...)
+		switch {
+		case args.SICHostname == "" && args.SICBaseURL == "":
+			allErrs = append(allErrs, field.Required(path.Child("sicHostname"), "either sicHostname or sicBaseUrl is required"))
+		case args.SICBaseURL != "":
+			allErrs = append(allErrs, validateRequiredURL(path.Child("sicBaseUrl"), args.SICBaseURL)...)
+		default:
+			allErrs = append(allErrs, validateHostname(path.Child("sicHostname"), args.SICHostname)...)
+		}
+		if !timeSeriesIntervalPattern.MatchString(args.TimeSeriesInterval) {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeSeriesInterval"), args.TimeSeriesInterval, "must be a number of hours, days, weeks or months, e.g. \"1 day\""))
+		}
+		if !validEntityAggregations.Has(string(args.EntityAggregation)) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("entityAggregation"), args.EntityAggregation, validEntityAggregations.List()))
+		}
+	case config.StaticFileCarbonDataProvider:
+		if args.StaticFileProvider.Path == "" {
+			allErrs = append(allErrs, field.Required(path.Child("staticFileProvider", "path"), ""))
+		}
+	case config.HTTPJSONCarbonDataProvider:
+		allErrs = append(allErrs, validateRequiredURL(path.Child("httpJSONProvider", "url"), args.HTTPJSONProvider.URL)...)
+	case config.PrometheusCarbonDataProvider:
+		spec, specPath := args.PrometheusProvider, path.Child("prometheusProvider")
+		allErrs = append(allErrs, validateRequiredURL(specPath.Child("address"), spec.Address)...)
+		if spec.EnergyQuery == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("energyQuery"), ""))
+		}
+		if spec.StepSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("stepSeconds"), spec.StepSeconds, "must be greater than 0"))
+		}
+		if spec.DefaultCarbonIntensity < 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("defaultCarbonIntensity"), spec.DefaultCarbonIntensity, "must be greater than or equal to 0"))
+		}
+		for region, intensity := range spec.CarbonIntensity {
+			if intensity < 0 {
+				allErrs = append(allErrs, field.Invalid(specPath.Child("carbonIntensity").Key(region), intensity, "must be greater than or equal to 0"))
+			}
+		}
+	case config.NodeSustainabilityCarbonDataProvider:
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("provider"), args.Provider, validCarbonDataProviders.List()))
+	}
+	return allErrs
+}
+
+// validateClientSecret checks that exactly one source of the SIC client secret is set.
+func validateClientSecret(path *field.Path, args *config.GreenSchedulingArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	sources := 0
+	if args.ClientSecret != "" {
+		sources++
+	}
+	if args.ClientSecretFile != "" {
+		sources++
+	}
+	if ref := args.ClientSecretRef; ref != (config.SecretKeyRef{}) {
+		refPath := path.Child("clientSecretRef")
+		if ref.Namespace == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("namespace"), ""))
+		}
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+		}
+		if ref.Key == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("key"), ""))
+		}
+		sources++
+	}
+	if sources != 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("clientSecret"), "<redacted>", "exactly one of clientSecret, clientSecretRef or clientSecretFile is required"))
+	}
+	return allErrs
+}
+
+// validateLocationFallback checks that the location fallback filters by SIC location keys, which
+// only the SIC provider supports, directly or through the NodeSustainability controller.
+func validateLocationFallback(path *field.Path, args *config.GreenSchedulingArgs) field.ErrorList {
+	var allErrs field.ErrorList
+	fallback := args.LocationFallback
+	if fallback.ZoneFilterKey == "" && fallback.RegionFilterKey == "" {
+		return nil
+	}
+	if args.Provider != config.SICCarbonDataProvider && args.Provider != config.NodeSustainabilityCarbonDataProvider {
+		allErrs = append(allErrs, field.Forbidden(path, "location fallback is not supported by the "+string(args.Provider)+" carbon data provider"))
+	}
+	if fallback.ZoneFilterKey != "" && !validLocationFilterKeys.Has(fallback.ZoneFilterKey) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("zoneFilterKey"), fallback.ZoneFilterKey, validLocationFilterKeys.List()))
+	}
+	if fallback.RegionFilterKey != "" && !validLocationFilterKeys.Has(fallback.RegionFilterKey) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("regionFilterKey"), fallback.RegionFilterKey, validLocationFilterKeys.List()))
+	}
+	return allErrs
+}
+
+// validateRequiredURL checks that the value is set to an absolute http or https URL.
+func validateRequiredURL(path *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(path, "")}
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return field.ErrorList{field.Invalid(path, value, "must be an absolute http or https URL")}
+	}
+	return nil
+}
+
+// validateHostname checks that the value is a host name, optionally followed by a port, without
+// a scheme or path.
+func validateHostname(path *field.Path, value string) field.ErrorList {
+	u, err := url.Parse("https://" + value)
+	if err != nil || u.Host != value || u.Hostname() == "" {
+		return field.ErrorList{field.Invalid(path, value, "must be a host name, optionally followed by a port, without a scheme or path")}
+	}
+	return nil
+}
@@ -67,3 +67,265 @@ func TestValidateNodeResourceTopologyMatchArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGreenSchedulingArgs(t *testing.T) {
+	validArgs := func() *config.GreenSchedulingArgs {
+		return &config.GreenSchedulingArgs{
+			Provider:                       config.SICCarbonDataProvider,
+			TokenURL:                       "https://sso.example.com/token",
+			ClientID:                       "client-id",
+			ClientSecret:                   "client-secret",
+			SICHostname:                    "sic.example.com",
+			TimeSeriesInterval:             "1 day",
+			EntityAggregation:              config.SumEntityAggregation,
+			SerialNumLabel:                 "example.com/serial-number",
+			ConsiderationDays:              7,
+			CO2DecayWeight:                 1,
+			TotalCO2Weight:                 0.5,
+			CostWeight:                     0.1,
+			DecayRate:                      0.05,
+			DecayModel:                     config.LogisticDecayModel,
+			ScoreNormalization:             config.MaxRatioScoreNormalization,
+			ScoreCacheTTLSeconds:           600,
+			ScoreCacheRefreshPeriodSeconds: 300,
+			ScoreBreakdown:                 config.DisabledScoreBreakdown,
+			EmbodiedCarbon: config.EmbodiedCarbonSpec{
+				LifetimeYears: 5,
+				Preference:    config.AmortizedEmbodiedCarbonPreference,
+			},
+		}
+	}
+
+	testCases := []struct {
+		description    string
+		modify         func(args *config.GreenSchedulingArgs)
+		expectedFields []string
+	}{
+		{
+			description: "correct config",
+			modify:      func(args *config.GreenSchedulingArgs) {},
+		},
+		{
+			description: "correct config, SIC base URL instead of hostname",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.SICHostname = ""
+				args.SICBaseURL = "https://127.0.0.1:8443"
+			},
+		},
+		{
+			description: "correct config, static file provider without SIC args",
+			modify: func(args *config.GreenSchedulingArgs) {
+				*args = config.GreenSchedulingArgs{
+					Provider:                       config.StaticFileCarbonDataProvider,
+					StaticFileProvider:             config.StaticFileProviderSpec{Path: "/etc/carbon.json"},
+					SerialNumLabel:                 args.SerialNumLabel,
+					ConsiderationDays:              args.ConsiderationDays,
+					EnergyWeight:                   1,
+					DecayModel:                     args.DecayModel,
+					ScoreNormalization:             args.ScoreNormalization,
+					ScoreCacheTTLSeconds:           args.ScoreCacheTTLSeconds,
+					ScoreCacheRefreshPeriodSeconds: args.ScoreCacheRefreshPeriodSeconds,
+					ScoreBreakdown:                 args.ScoreBreakdown,
+					EmbodiedCarbon:                 args.EmbodiedCarbon,
+				}
+			},
+		},
+		{
+			description: "missing serial number label",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.SerialNumLabel = ""
+			},
+			expectedFields: []string{"serialNumLabel"},
+		},
+		{
+			description: "invalid serial number label",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.SerialNumLabel = "serial number"
+			},
+			expectedFields: []string{"serialNumLabel"},
+		},
+		{
+			description: "missing SIC credentials",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.TokenURL = ""
+				args.ClientID = ""
+				args.ClientSecret = ""
+			},
+			expectedFields: []string{"tokenUrl", "clientId", "clientSecret"},
+		},
+		{
+			description: "relative token URL",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.TokenURL = "sso.example.com/token"
+			},
+			expectedFields: []string{"tokenUrl"},
+		},
+		{
+			description: "SIC hostname with a scheme",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.SICHostname = "https://sic.example.com"
+			},
+			expectedFields: []string{"sicHostname"},
+		},
+		{
+			description: "SIC base URL with an unsupported scheme",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.SICBaseURL = "ftp://sic.example.com"
+			},
+			expectedFields: []string{"sicBaseUrl"},
+		},
+		{
+			description: "missing SIC hostname and base URL",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.SICHostname = ""
+			},
+			expectedFields: []string{"sicHostname"},
+		},
+		{
+			description: "several client secret sources",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.ClientSecretFile = "/etc/sic/client-secret"
+			},
+			expectedFields: []string{"clientSecret"},
+		},
+		{
+			description: "incomplete client secret reference",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.ClientSecret = ""
+				args.ClientSecretRef = config.SecretKeyRef{Name: "sic"}
+			},
+			expectedFields: []string{"clientSecretRef.namespace", "clientSecretRef.key"},
+		},
+		{
+			description: "unsupported time series interval",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.TimeSeriesInterval = "daily"
+			},
+			expectedFields: []string{"timeSeriesInterval"},
+		},
+		{
+			description: "unsupported entity aggregation",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.EntityAggregation = "Max"
+			},
+			expectedFields: []string{"entityAggregation"},
+		},
+		{
+			description: "unsupported provider",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.Provider = "Unknown"
+			},
+			expectedFields: []string{"provider"},
+		},
+		{
+			description: "incomplete Prometheus provider",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.Provider = config.PrometheusCarbonDataProvider
+				args.PrometheusProvider = config.PrometheusProviderSpec{
+					Address:         "prometheus:9090",
+					CarbonIntensity: map[string]float64{"eu-west": -1},
+				}
+			},
+			expectedFields: []string{
+				"prometheusProvider.address",
+				"prometheusProvider.energyQuery",
+				"prometheusProvider.stepSeconds",
+				"prometheusProvider.carbonIntensity[eu-west]",
+			},
+		},
+		{
+			description: "all weights zero",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.CO2DecayWeight = 0
+				args.TotalCO2Weight = 0
+				args.CostWeight = 0
+			},
+			// Reported on the args themselves, validated here without a path.
+			expectedFields: []string{"<nil>"},
+		},
+		{
+			description: "only the embodied carbon weight",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.CO2DecayWeight = 0
+				args.TotalCO2Weight = 0
+				args.CostWeight = 0
+				args.EmbodiedCarbon.Weight = 1
+			},
+		},
+		{
+			description: "negative weight and decay rate out of range",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.CostWeight = -1
+				args.DecayRate = 2
+			},
+			expectedFields: []string{"costWeight", "decayRate"},
+		},
+		{
+			description: "EWMA decay model without half-life",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.DecayModel = config.EWMADecayModel
+			},
+			expectedFields: []string{"decayHalfLifeHours"},
+		},
+		{
+			description: "refresh period longer than the TTL",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.ScoreCacheRefreshPeriodSeconds = 900
+			},
+			expectedFields: []string{"scoreCacheRefreshPeriodSeconds"},
+		},
+		{
+			description: "consolidation packing weight out of range",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.Consolidation.PackingWeight = 1.5
+			},
+			expectedFields: []string{"consolidation.packingWeight"},
+		},
+		{
+			description: "unsupported score breakdown",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.ScoreBreakdown = "Sometimes"
+			},
+			expectedFields: []string{"scoreBreakdown"},
+		},
+		{
+			description: "invalid embodied carbon footprint",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.EmbodiedCarbon.Footprints = map[string]float64{"DL380": 1}
+			},
+			expectedFields: []string{"embodiedCarbon.footprints[DL380]"},
+		},
+		{
+			description: "location fallback by an unsupported key",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.LocationFallback.ZoneFilterKey = "entityMake"
+			},
+			expectedFields: []string{"locationFallback.zoneFilterKey"},
+		},
+		{
+			description: "location fallback with a provider without locations",
+			modify: func(args *config.GreenSchedulingArgs) {
+				args.Provider = config.HTTPJSONCarbonDataProvider
+				args.HTTPJSONProvider.URL = "https://carbon.example.com/nodes/{key}"
+				args.LocationFallback.RegionFilterKey = "locationCountry"
+			},
+			expectedFields: []string{"locationFallback"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			args := validArgs()
+			testCase.modify(args)
+			errs := ValidateGreenSchedulingArgs(nil, args)
+
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(testCase.expectedFields, ",") {
+				t.Errorf("expected errors for fields %v, got %v", testCase.expectedFields, errs)
+			}
+		})
+	}
+}
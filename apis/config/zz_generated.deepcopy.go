@@ -27,6 +27,54 @@ import (
 	apisconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonCeilingSpec) DeepCopyInto(out *CarbonCeilingSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonCeilingSpec.
+func (in *CarbonCeilingSpec) DeepCopy() *CarbonCeilingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonCeilingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonDeferralSpec) DeepCopyInto(out *CarbonDeferralSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonDeferralSpec.
+func (in *CarbonDeferralSpec) DeepCopy() *CarbonDeferralSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonDeferralSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsolidationSpec) DeepCopyInto(out *ConsolidationSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsolidationSpec.
+func (in *ConsolidationSpec) DeepCopy() *ConsolidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsolidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoschedulingArgs) DeepCopyInto(out *CoschedulingArgs) {
 	*out = *in
@@ -52,10 +100,42 @@ func (in *CoschedulingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmbodiedCarbonSpec) DeepCopyInto(out *EmbodiedCarbonSpec) {
+	*out = *in
+	if in.Footprints != nil {
+		in, out := &in.Footprints, &out.Footprints
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmbodiedCarbonSpec.
+func (in *EmbodiedCarbonSpec) DeepCopy() *EmbodiedCarbonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EmbodiedCarbonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GreenSchedulingArgs) DeepCopyInto(out *GreenSchedulingArgs) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	out.ClientSecretRef = in.ClientSecretRef
+	out.StaticFileProvider = in.StaticFileProvider
+	out.HTTPJSONProvider = in.HTTPJSONProvider
+	in.PrometheusProvider.DeepCopyInto(&out.PrometheusProvider)
+	out.CarbonDeferral = in.CarbonDeferral
+	out.CarbonCeiling = in.CarbonCeiling
+	in.EmbodiedCarbon.DeepCopyInto(&out.EmbodiedCarbon)
+	in.LocationFallback.DeepCopyInto(&out.LocationFallback)
+	out.Consolidation = in.Consolidation
 	return
 }
 
@@ -77,6 +157,39 @@ func (in *GreenSchedulingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJSONFieldMappings) DeepCopyInto(out *HTTPJSONFieldMappings) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJSONFieldMappings.
+func (in *HTTPJSONFieldMappings) DeepCopy() *HTTPJSONFieldMappings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJSONFieldMappings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJSONProviderSpec) DeepCopyInto(out *HTTPJSONProviderSpec) {
+	*out = *in
+	out.FieldMappings = in.FieldMappings
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJSONProviderSpec.
+func (in *HTTPJSONProviderSpec) DeepCopy() *HTTPJSONProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJSONProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadVariationRiskBalancingArgs) DeepCopyInto(out *LoadVariationRiskBalancingArgs) {
 	*out = *in
@@ -103,6 +216,29 @@ func (in *LoadVariationRiskBalancingArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocationFallbackSpec) DeepCopyInto(out *LocationFallbackSpec) {
+	*out = *in
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocationFallbackSpec.
+func (in *LocationFallbackSpec) DeepCopy() *LocationFallbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocationFallbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LowRiskOverCommitmentArgs) DeepCopyInto(out *LowRiskOverCommitmentArgs) {
 	*out = *in
@@ -304,6 +440,29 @@ func (in *PreemptionTolerationArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusProviderSpec) DeepCopyInto(out *PrometheusProviderSpec) {
+	*out = *in
+	if in.CarbonIntensity != nil {
+		in, out := &in.CarbonIntensity, &out.CarbonIntensity
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusProviderSpec.
+func (in *PrometheusProviderSpec) DeepCopy() *PrometheusProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScoringStrategy) DeepCopyInto(out *ScoringStrategy) {
 	*out = *in
@@ -325,6 +484,38 @@ func (in *ScoringStrategy) DeepCopy() *ScoringStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticFileProviderSpec) DeepCopyInto(out *StaticFileProviderSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticFileProviderSpec.
+func (in *StaticFileProviderSpec) DeepCopy() *StaticFileProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticFileProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SySchedArgs) DeepCopyInto(out *SySchedArgs) {
 	*out = *in
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling"
 )
@@ -196,3 +197,190 @@ type PodGroupList struct {
 	// Items is the list of PodGroup
 	Items []PodGroup `json:"items"`
 }
+
+// NodeSustainability is the sustainability data of a node, named after the node. It is
+// populated by the NodeSustainability controller from the carbon data provider and read by
+// the GreenScheduling plugin, so that all scheduler replicas share a single view of it.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName={nsus}
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=unapproved, experimental-only"
+// +kubebuilder:printcolumn:name="Key",JSONPath=".spec.key",type=string,description="Key is the serial number or location the carbon data of the node is fetched for."
+// +kubebuilder:printcolumn:name="CO2",JSONPath=".status.totalCO2",type=string,description="TotalCO2 is the CO2 emitted by the node in metric tons."
+// +kubebuilder:printcolumn:name="Score",JSONPath=".status.score",type=string,description="Score is the sustainability score of the node."
+// +kubebuilder:printcolumn:name="Updated",JSONPath=".status.lastUpdateTime",type=date,description="LastUpdateTime is the time the carbon data was last fetched."
+type NodeSustainability struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the carbon data of the node.
+	// +optional
+	Spec NodeSustainabilitySpec `json:"spec,omitempty"`
+
+	// Status represents the latest carbon data of the node.
+	// This data may not be up to date.
+	// +optional
+	Status NodeSustainabilityStatus `json:"status,omitempty"`
+}
+
+// NodeSustainabilitySpec identifies the carbon data of a node.
+type NodeSustainabilitySpec struct {
+	// Key is the serial number of the node, or the key of the location it falls back to,
+	// that its carbon data is fetched for.
+	Key string `json:"key,omitempty"`
+}
+
+// NodeSustainabilityStatus represents the carbon data of a node over the consideration window.
+type NodeSustainabilityStatus struct {
+	// TotalCO2 is the CO2 emitted by the node in metric tons.
+	// +optional
+	TotalCO2 resource.Quantity `json:"totalCO2,omitempty"`
+
+	// TotalCost is the cost of the node in USD.
+	// +optional
+	TotalCost resource.Quantity `json:"totalCost,omitempty"`
+
+	// TotalKwh is the energy consumed by the node in kWh.
+	// +optional
+	TotalKwh resource.Quantity `json:"totalKwh,omitempty"`
+
+	// Series is the time series of the CO2 emissions and energy consumption of the node.
+	// +optional
+	Series []EmissionSample `json:"series,omitempty"`
+
+	// Hardware describes the hardware of the node, if known.
+	// +optional
+	Hardware NodeHardware `json:"hardware,omitempty"`
+
+	// Score is the sustainability score of the node, calculated with the controller's weights.
+	// +optional
+	Score resource.Quantity `json:"score,omitempty"`
+
+	// LastUpdateTime is the time the carbon data was last fetched.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// EmissionSample is a single data point of the emission time series of a node.
+type EmissionSample struct {
+	// Time is the start of the interval of the data point.
+	Time metav1.Time `json:"time"`
+
+	// CO2 is the CO2 emitted during the interval in metric tons.
+	CO2 resource.Quantity `json:"co2"`
+
+	// Kwh is the energy consumed during the interval in kWh.
+	Kwh resource.Quantity `json:"kwh"`
+}
+
+// NodeHardware describes the hardware of a node, used to account for its embodied carbon.
+type NodeHardware struct {
+	// Make is the manufacturer of the hardware.
+	// +optional
+	Make string `json:"make,omitempty"`
+
+	// Model is the model of the hardware.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// ManufactureTime is the time the hardware was manufactured.
+	// +optional
+	ManufactureTime *metav1.Time `json:"manufactureTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeSustainabilityList is a collection of node sustainabilities.
+type NodeSustainabilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of NodeSustainability
+	Items []NodeSustainability `json:"items"`
+}
+
+// CarbonSchedule describes recurring time windows during which the carbon intensity of the
+// nodes of a location differs from what their historical emissions suggest, e.g. solar and
+// wind peaks or contracted green power periods. The GreenScheduling plugin scales the
+// sustainability scores of the selected nodes by the multiplier of the active windows.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName={csched}
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=unapproved, experimental-only"
+// +kubebuilder:printcolumn:name="Time Zone",JSONPath=".spec.timeZone",type=string,description="TimeZone is the time zone the windows are expressed in."
+// +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Age is the time CarbonSchedule was created."
+type CarbonSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the location and its recurring time windows.
+	// +optional
+	Spec CarbonScheduleSpec `json:"spec,omitempty"`
+}
+
+// CarbonScheduleSpec describes the nodes of a location and its recurring time windows.
+type CarbonScheduleSpec struct {
+	// NodeSelector selects the nodes of the location, e.g. by their topology.kubernetes.io/zone
+	// label. An empty selector selects all nodes.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// TimeZone is the IANA name of the time zone the windows are expressed in, e.g. Europe/Berlin.
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows are the recurring time windows of the location. The multipliers of overlapping
+	// windows are multiplied.
+	// +optional
+	Windows []CarbonWindow `json:"windows,omitempty"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type Weekday string
+
+// CarbonWindow is a time window recurring on the given days of the week.
+type CarbonWindow struct {
+	// Days are the days of the week the window starts on. Defaults to every day.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day the window starts at, as HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window ends at, as HH:MM. Windows ending at or before their
+	// start end on the next day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// CarbonIntensityMultiplier scales the carbon intensity of the nodes during the window, e.g.
+	// 0.2 while solar power covers most of their consumption. Sustainability scores are divided
+	// by it, so that multipliers below 1 favour the nodes and multipliers above 1 penalise them.
+	CarbonIntensityMultiplier resource.Quantity `json:"carbonIntensityMultiplier"`
+}
+
+// +kubebuilder:object:root=true
+
+// CarbonScheduleList is a collection of carbon schedules.
+type CarbonScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of CarbonSchedule
+	Items []CarbonSchedule `json:"items"`
+}
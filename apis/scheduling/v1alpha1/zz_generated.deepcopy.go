@@ -22,9 +22,116 @@ package v1alpha1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonSchedule) DeepCopyInto(out *CarbonSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonSchedule.
+func (in *CarbonSchedule) DeepCopy() *CarbonSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CarbonSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonScheduleList) DeepCopyInto(out *CarbonScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CarbonSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonScheduleList.
+func (in *CarbonScheduleList) DeepCopy() *CarbonScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CarbonScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonScheduleSpec) DeepCopyInto(out *CarbonScheduleSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]CarbonWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonScheduleSpec.
+func (in *CarbonScheduleSpec) DeepCopy() *CarbonScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarbonWindow) DeepCopyInto(out *CarbonWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	out.CarbonIntensityMultiplier = in.CarbonIntensityMultiplier.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarbonWindow.
+func (in *CarbonWindow) DeepCopy() *CarbonWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CarbonWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticQuota) DeepCopyInto(out *ElasticQuota) {
 	*out = *in
@@ -135,6 +242,148 @@ func (in *ElasticQuotaStatus) DeepCopy() *ElasticQuotaStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmissionSample) DeepCopyInto(out *EmissionSample) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	out.CO2 = in.CO2.DeepCopy()
+	out.Kwh = in.Kwh.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmissionSample.
+func (in *EmissionSample) DeepCopy() *EmissionSample {
+	if in == nil {
+		return nil
+	}
+	out := new(EmissionSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHardware) DeepCopyInto(out *NodeHardware) {
+	*out = *in
+	if in.ManufactureTime != nil {
+		in, out := &in.ManufactureTime, &out.ManufactureTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHardware.
+func (in *NodeHardware) DeepCopy() *NodeHardware {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHardware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSustainability) DeepCopyInto(out *NodeSustainability) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSustainability.
+func (in *NodeSustainability) DeepCopy() *NodeSustainability {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSustainability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeSustainability) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSustainabilityList) DeepCopyInto(out *NodeSustainabilityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeSustainability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSustainabilityList.
+func (in *NodeSustainabilityList) DeepCopy() *NodeSustainabilityList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSustainabilityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeSustainabilityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSustainabilitySpec) DeepCopyInto(out *NodeSustainabilitySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSustainabilitySpec.
+func (in *NodeSustainabilitySpec) DeepCopy() *NodeSustainabilitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSustainabilitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSustainabilityStatus) DeepCopyInto(out *NodeSustainabilityStatus) {
+	*out = *in
+	out.TotalCO2 = in.TotalCO2.DeepCopy()
+	out.TotalCost = in.TotalCost.DeepCopy()
+	out.TotalKwh = in.TotalKwh.DeepCopy()
+	if in.Series != nil {
+		in, out := &in.Series, &out.Series
+		*out = make([]EmissionSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Hardware.DeepCopyInto(&out.Hardware)
+	out.Score = in.Score.DeepCopy()
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSustainabilityStatus.
+func (in *NodeSustainabilityStatus) DeepCopy() *NodeSustainabilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSustainabilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGroup) DeepCopyInto(out *PodGroup) {
 	*out = *in
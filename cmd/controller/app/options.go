@@ -27,6 +27,7 @@ type ServerRunOptions struct {
 	ApiServerBurst       int
 	Workers              int
 	EnableLeaderElection bool
+	GreenSchedulingArgs  string
 }
 
 func NewServerRunOptions() *ServerRunOptions {
@@ -42,4 +43,5 @@ func (s *ServerRunOptions) addAllFlags() {
 	pflag.IntVar(&s.ApiServerBurst, "burst", 10, "burst of query apiserver.")
 	pflag.IntVar(&s.Workers, "workers", 1, "workers of scheduler-plugin-controllers.")
 	pflag.BoolVar(&s.EnableLeaderElection, "enableLeaderElection", s.EnableLeaderElection, "If EnableLeaderElection for controller.")
+	pflag.StringVar(&s.GreenSchedulingArgs, "greenSchedulingArgs", "", "Path to the GreenSchedulingArgs the NodeSustainability controller fetches carbon data with. The controller is disabled if empty.")
 }
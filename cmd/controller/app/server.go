@@ -17,6 +17,9 @@ limitations under the License.
 package app
 
 import (
+	"fmt"
+	"os"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -25,6 +28,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	configscheme "sigs.k8s.io/scheduler-plugins/apis/config/scheme"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
 	schedulingv1a1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	"sigs.k8s.io/scheduler-plugins/pkg/controllers"
 )
@@ -62,6 +68,8 @@ func Run(s *ServerRunOptions) error {
 		return err
 	}
 
+	ctx := ctrl.SetupSignalHandler()
+
 	if err = (&controllers.PodGroupReconciler{
 		Client:  mgr.GetClient(),
 		Scheme:  mgr.GetScheme(),
@@ -80,6 +88,22 @@ func Run(s *ServerRunOptions) error {
 		return err
 	}
 
+	if s.GreenSchedulingArgs != "" {
+		args, err := loadGreenSchedulingArgs(s.GreenSchedulingArgs)
+		if err != nil {
+			setupLog.Error(err, "unable to load GreenSchedulingArgs")
+			return err
+		}
+		reconciler, err := controllers.NewNodeSustainabilityReconciler(ctx, mgr, args, s.Workers)
+		if err == nil {
+			err = reconciler.SetupWithManager(mgr)
+		}
+		if err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NodeSustainability")
+			return err
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		return err
@@ -89,9 +113,29 @@ func Run(s *ServerRunOptions) error {
 		return err
 	}
 
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "unable to start manager")
 		return err
 	}
 	return nil
 }
+
+// loadGreenSchedulingArgs reads, defaults and validates the GreenSchedulingArgs in the file.
+func loadGreenSchedulingArgs(path string) (*config.GreenSchedulingArgs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	obj, _, err := configscheme.Codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	args, ok := obj.(*config.GreenSchedulingArgs)
+	if !ok {
+		return nil, fmt.Errorf("expected GreenSchedulingArgs in %s but got %T", path, obj)
+	}
+	if errs := validation.ValidateGreenSchedulingArgs(nil, args); len(errs) > 0 {
+		return nil, fmt.Errorf("validation failed for GreenSchedulingArgs: %w", errs.ToAggregate())
+	}
+	return args, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command greenscheduling-replay scores recorded SIC responses offline under one or more
+// GreenSchedulingArgs files, printing the scores and rankings of the nodes side by side.
+//
+//	greenscheduling-replay --nodes nodes.yaml --usage-by-entity usage.json \
+//	    --usage-series-dir series/ --args current.yaml --args candidate.yaml
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/replay"
+)
+
+func main() {
+	var (
+		nodesPath         string
+		usageByEntityPath string
+		usageSeriesDir    string
+		entityAggregation string
+		argsPaths         []string
+	)
+	pflag.StringVar(&nodesPath, "nodes", "", "Path to a JSON or YAML NodeList, e.g. the output of `kubectl get nodes -o yaml`.")
+	pflag.StringVar(&usageByEntityPath, "usage-by-entity", "", "Path to a recorded SIC usage by entity response.")
+	pflag.StringVar(&usageSeriesDir, "usage-series-dir", "", "Directory of recorded SIC usage series responses, named <serial number>.json.")
+	pflag.StringVar(&entityAggregation, "entity-aggregation", string(config.SumEntityAggregation), "How the usage of the SIC entities sharing a serial number is aggregated: Sum, or Average weighted by their energy consumption.")
+	pflag.StringArrayVar(&argsPaths, "args", nil, "Path to a GreenSchedulingArgs file to score the nodes with. Repeat to compare candidates.")
+	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+	pflag.Parse()
+
+	if err := run(nodesPath, usageByEntityPath, usageSeriesDir, config.EntityAggregationType(entityAggregation), argsPaths); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(nodesPath, usageByEntityPath, usageSeriesDir string, entityAggregation config.EntityAggregationType, argsPaths []string) error {
+	if nodesPath == "" || usageByEntityPath == "" || len(argsPaths) == 0 {
+		return errors.New("--nodes, --usage-by-entity and at least one --args are required")
+	}
+	if entityAggregation != config.SumEntityAggregation && entityAggregation != config.AverageEntityAggregation {
+		return fmt.Errorf("invalid --entity-aggregation %q", entityAggregation)
+	}
+
+	nodes, err := replay.LoadNodes(nodesPath)
+	if err != nil {
+		return err
+	}
+	recording, err := replay.LoadRecording(usageByEntityPath, usageSeriesDir, entityAggregation)
+	if err != nil {
+		return err
+	}
+	candidates := make([]replay.Candidate, len(argsPaths))
+	for i, path := range argsPaths {
+		if candidates[i], err = replay.LoadCandidate(path); err != nil {
+			return err
+		}
+	}
+
+	results, err := replay.Replay(context.Background(), candidates, nodes, recording)
+	if err != nil {
+		return err
+	}
+	return replay.Print(os.Stdout, nodes, results)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubernetes/pkg/scheduler/framework/parallelize"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/scorecache"
+)
+
+// pendingRequeueDelay is the interval at which nodes whose carbon data has not been fetched yet
+// are reconciled again, until it is.
+const pendingRequeueDelay = 10 * time.Second
+
+// NodeSustainabilityReconciler maintains a NodeSustainability object per node, holding the
+// carbon data of the node fetched from the provider configured in the GreenScheduling args.
+type NodeSustainabilityReconciler struct {
+	client.Client
+	Scheme  *runtime.Scheme
+	Workers int
+
+	// Scorer matches nodes with the keys of their carbon data.
+	Scorer *greenscheduling.Scorer
+	// Cache holds the carbon data and sustainability scores of the keys of all reconciled nodes,
+	// fetched at once by its background refresher rather than per node.
+	Cache *scorecache.Cache
+	// RefreshPeriod is the interval at which the status of a node is updated from the cache.
+	RefreshPeriod time.Duration
+}
+
+// NewNodeSustainabilityReconciler creates a NodeSustainabilityReconciler fetching carbon data
+// from the provider configured in the GreenScheduling args. The cache refresher is added to the
+// manager, while any background work of the provider stops when the context is done.
+func NewNodeSustainabilityReconciler(ctx context.Context, mgr ctrl.Manager, args *config.GreenSchedulingArgs, workers int) (*NodeSustainabilityReconciler, error) {
+	if args.Provider == config.NodeSustainabilityCarbonDataProvider {
+		return nil, fmt.Errorf("the NodeSustainability controller cannot use the %q carbon data provider", args.Provider)
+	}
+	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	provider, err := carbonprovider.New(ctx, args, &providerHandle{
+		clientSet:    clientSet,
+		kubeConfig:   mgr.GetConfig(),
+		parallelizer: parallelize.NewParallelizer(parallelize.DefaultParallelism),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating carbon data provider: %w", err)
+	}
+
+	scorer := greenscheduling.NewScorer(args, provider)
+	refreshPeriod := time.Duration(args.ScoreCacheRefreshPeriodSeconds) * time.Second
+	cache := scorecache.New(time.Duration(args.ScoreCacheTTLSeconds)*time.Second, refreshPeriod, scorer.Fetch)
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		cache.Run(ctx)
+		return nil
+	})); err != nil {
+		return nil, fmt.Errorf("error adding the carbon data refresher: %w", err)
+	}
+
+	return &NodeSustainabilityReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Workers:       workers,
+		Scorer:        scorer,
+		Cache:         cache,
+		RefreshPeriod: refreshPeriod,
+	}, nil
+}
+
+// providerHandle provides carbon data providers with the clients of the controller.
+type providerHandle struct {
+	clientSet    kubernetes.Interface
+	kubeConfig   *rest.Config
+	parallelizer parallelize.Parallelizer
+}
+
+func (h *providerHandle) ClientSet() kubernetes.Interface        { return h.clientSet }
+func (h *providerHandle) KubeConfig() *rest.Config               { return h.kubeConfig }
+func (h *providerHandle) Parallelizer() parallelize.Parallelizer { return h.parallelizer }
+
+// +kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=nodesustainabilities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=nodesustainabilities/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+func (r *NodeSustainabilityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	node := &v1.Node{}
+	if err := r.Get(ctx, req.NamespacedName, node); err != nil {
+		// The NodeSustainability of a deleted node is garbage collected along with it.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	key, ok := r.Scorer.NodeKey(node.Labels)
+	if !ok {
+		// Drop the carbon data of nodes that lost their serial number and location labels, so that
+		// the scheduler does not keep scoring them with it.
+		log.V(5).Info("node cannot be matched with any carbon data")
+		ns := &schedv1alpha1.NodeSustainability{ObjectMeta: metav1.ObjectMeta{Name: node.Name}}
+		return ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, ns))
+	}
+
+	ns, err := r.ensureNodeSustainability(ctx, node, key)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.Cache.Track(key)
+	entry, ok := r.Cache.Get(key)
+	if !ok {
+		log.V(4).Info("no carbon data found yet", "key", key)
+		return ctrl.Result{RequeueAfter: pendingRequeueDelay}, nil
+	}
+
+	newNS := ns.DeepCopy()
+	newNS.Status = carbonprovider.NewNodeSustainabilityStatus(entry.Profile, entry.Score, entry.UpdatedAt)
+	if err := r.Status().Patch(ctx, newNS, client.MergeFrom(ns)); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.RefreshPeriod}, nil
+}
+
+// ensureNodeSustainability returns the NodeSustainability of the node, creating it, owned by
+// the node, if it does not exist yet, and updating its key if the labels of the node changed.
+func (r *NodeSustainabilityReconciler) ensureNodeSustainability(ctx context.Context, node *v1.Node, key string) (*schedv1alpha1.NodeSustainability, error) {
+	ns := &schedv1alpha1.NodeSustainability{}
+	err := r.Get(ctx, types.NamespacedName{Name: node.Name}, ns)
+	switch {
+	case apierrs.IsNotFound(err):
+		ns = &schedv1alpha1.NodeSustainability{
+			ObjectMeta: metav1.ObjectMeta{Name: node.Name},
+			Spec:       schedv1alpha1.NodeSustainabilitySpec{Key: key},
+		}
+		if err := controllerutil.SetControllerReference(node, ns, r.Scheme); err != nil {
+			return nil, err
+		}
+		return ns, r.Create(ctx, ns)
+	case err != nil:
+		return nil, err
+	case ns.Spec.Key != key:
+		ns.Spec.Key = key
+		return ns, r.Update(ctx, ns)
+	}
+	return ns, nil
+}
+
+// SetupWithManager reconciles nodes when they are created or their labels change, and again
+// every refresh period. Status updates of nodes do not affect their carbon data.
+func (r *NodeSustainabilityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.Node{}, builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.Workers}).
+		Complete(r)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/scorecache"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// fakeCarbonDataProvider serves the carbon data of the serial numbers it holds, recording the
+// keys of every request.
+type fakeCarbonDataProvider struct {
+	data     map[string]carbonprovider.CarbonData
+	requests [][]string
+}
+
+func (p *fakeCarbonDataProvider) GetCarbonData(ctx context.Context, keys []string, startTime, endTime time.Time) (map[string]carbonprovider.CarbonData, error) {
+	p.requests = append(p.requests, keys)
+	data := map[string]carbonprovider.CarbonData{}
+	for _, key := range keys {
+		if d, ok := p.data[key]; ok {
+			data[key] = d
+		}
+	}
+	return data, nil
+}
+
+func TestNodeSustainabilityController_Run(t *testing.T) {
+	ctx := context.TODO()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	nodes := []*v1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-a", UID: "uid-a", Labels: map[string]string{"serial-number": "SN1"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-b", UID: "uid-b", Labels: map[string]string{"serial-number": "SN2"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node-c", UID: "uid-c"}},
+	}
+	client := fake.NewClientBuilder().
+		WithScheme(s).
+		WithStatusSubresource(&v1alpha1.NodeSustainability{}).
+		WithObjects(nodes[0], nodes[1], nodes[2]).
+		Build()
+
+	emittedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	provider := &fakeCarbonDataProvider{data: map[string]carbonprovider.CarbonData{
+		"SN1": {
+			Emissions: []sustainabilityprofile.EmissionDataPoint{sustainabilityprofile.NewEmissionDataPoint(0.000125, 0.5, emittedAt)},
+			TotalCo2:  0.003,
+			TotalCost: 1.5,
+			TotalKwh:  12,
+		},
+	}}
+	args := &config.GreenSchedulingArgs{
+		SerialNumLabel:    "serial-number",
+		ConsiderationDays: 1,
+		CO2DecayWeight:    1,
+		TotalCO2Weight:    1,
+		CostWeight:        1,
+		DecayRate:         0.1,
+	}
+	scorer := greenscheduling.NewScorer(args, provider)
+	controller := &NodeSustainabilityReconciler{
+		Client:        client,
+		Scheme:        s,
+		Scorer:        scorer,
+		Cache:         scorecache.New(time.Hour, time.Minute, scorer.Fetch),
+		RefreshPeriod: time.Minute,
+	}
+
+	reconcile := func(wantRequeue map[string]time.Duration) {
+		t.Helper()
+		for _, node := range nodes {
+			result, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: node.Name}})
+			if err != nil {
+				t.Fatalf("unexpected error reconciling %s: %v", node.Name, err)
+			}
+			if result.RequeueAfter != wantRequeue[node.Name] {
+				t.Errorf("expected %s to be requeued after %v, got %v", node.Name, wantRequeue[node.Name], result.RequeueAfter)
+			}
+		}
+	}
+	// Nodes are requeued until the cache fetched their carbon data, in a single request for all
+	// of them, and then every refresh period.
+	reconcile(map[string]time.Duration{"node-a": pendingRequeueDelay, "node-b": pendingRequeueDelay})
+	controller.Cache.Refresh(ctx)
+	reconcile(map[string]time.Duration{"node-a": time.Minute, "node-b": pendingRequeueDelay})
+	if len(provider.requests) != 1 || len(provider.requests[0]) != 2 {
+		t.Errorf("expected a single request for the keys of both nodes, got %v", provider.requests)
+	}
+
+	ns := &v1alpha1.NodeSustainability{}
+	if err := client.Get(ctx, types.NamespacedName{Name: "node-a"}, ns); err != nil {
+		t.Fatal(err)
+	}
+	if ns.Spec.Key != "SN1" {
+		t.Errorf("expected key SN1, got %q", ns.Spec.Key)
+	}
+	if len(ns.OwnerReferences) != 1 || ns.OwnerReferences[0].UID != "uid-a" {
+		t.Errorf("expected the NodeSustainability to be owned by its node, got %v", ns.OwnerReferences)
+	}
+	if ns.Status.LastUpdateTime == nil || ns.Status.Score.IsZero() {
+		t.Errorf("expected a scored status, got %+v", ns.Status)
+	}
+
+	// The status converts back to the carbon data it was fetched from.
+	data := carbonprovider.NewCarbonDataFromStatus(&ns.Status, emittedAt.Add(-time.Minute), time.Now())
+	if data.TotalCo2 != 0.003 || data.TotalCost != 1.5 || data.TotalKwh != 12 {
+		t.Errorf("expected totals 0.003 t, 1.5 USD and 12 kWh, got %v, %v and %v", data.TotalCo2, data.TotalCost, data.TotalKwh)
+	}
+	if len(data.Emissions) != 1 || data.Emissions[0].Co2 != 0.000125 || !data.Emissions[0].Time.Equal(emittedAt) {
+		t.Errorf("expected the emission series to round-trip, got %+v", data.Emissions)
+	}
+
+	// Nodes unknown to the provider get an empty NodeSustainability, unlabelled nodes none.
+	if err := client.Get(ctx, types.NamespacedName{Name: "node-b"}, ns); err != nil {
+		t.Fatal(err)
+	}
+	if ns.Status.LastUpdateTime != nil {
+		t.Errorf("expected no status for a node without carbon data, got %+v", ns.Status)
+	}
+	if err := client.Get(ctx, types.NamespacedName{Name: "node-c"}, ns); err == nil {
+		t.Errorf("expected no NodeSustainability for a node without a serial number")
+	}
+
+	// Nodes losing their serial number lose their NodeSustainability.
+	node := nodes[0].DeepCopy()
+	if err := client.Get(ctx, types.NamespacedName{Name: node.Name}, node); err != nil {
+		t.Fatal(err)
+	}
+	node.Labels = nil
+	if err := client.Update(ctx, node); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: node.Name}}); err != nil {
+		t.Fatalf("unexpected error reconciling %s: %v", node.Name, err)
+	}
+	if err := client.Get(ctx, types.NamespacedName{Name: node.Name}, ns); !apierrs.IsNotFound(err) {
+		t.Errorf("expected the NodeSustainability of a node without a serial number to be deleted, got %v", err)
+	}
+}
@@ -29,12 +29,28 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=scheduling.x-k8s.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("CarbonSchedule"):
+		return &schedulingv1alpha1.CarbonScheduleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CarbonScheduleSpec"):
+		return &schedulingv1alpha1.CarbonScheduleSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CarbonWindow"):
+		return &schedulingv1alpha1.CarbonWindowApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ElasticQuota"):
 		return &schedulingv1alpha1.ElasticQuotaApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ElasticQuotaSpec"):
 		return &schedulingv1alpha1.ElasticQuotaSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ElasticQuotaStatus"):
 		return &schedulingv1alpha1.ElasticQuotaStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("EmissionSample"):
+		return &schedulingv1alpha1.EmissionSampleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeHardware"):
+		return &schedulingv1alpha1.NodeHardwareApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeSustainability"):
+		return &schedulingv1alpha1.NodeSustainabilityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeSustainabilitySpec"):
+		return &schedulingv1alpha1.NodeSustainabilitySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeSustainabilityStatus"):
+		return &schedulingv1alpha1.NodeSustainabilityStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroup"):
 		return &schedulingv1alpha1.PodGroupApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroupSpec"):
@@ -28,10 +28,18 @@ type FakeSchedulingV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSchedulingV1alpha1) CarbonSchedules() v1alpha1.CarbonScheduleInterface {
+	return &FakeCarbonSchedules{c}
+}
+
 func (c *FakeSchedulingV1alpha1) ElasticQuotas(namespace string) v1alpha1.ElasticQuotaInterface {
 	return &FakeElasticQuotas{c, namespace}
 }
 
+func (c *FakeSchedulingV1alpha1) NodeSustainabilities() v1alpha1.NodeSustainabilityInterface {
+	return &FakeNodeSustainabilities{c}
+}
+
 func (c *FakeSchedulingV1alpha1) PodGroups(namespace string) v1alpha1.PodGroupInterface {
 	return &FakePodGroups{c, namespace}
 }
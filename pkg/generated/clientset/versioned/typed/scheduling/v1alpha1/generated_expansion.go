@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+type CarbonScheduleExpansion interface{}
+
 type ElasticQuotaExpansion interface{}
 
+type NodeSustainabilityExpansion interface{}
+
 type PodGroupExpansion interface{}
@@ -28,7 +28,9 @@ import (
 
 type SchedulingV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	CarbonSchedulesGetter
 	ElasticQuotasGetter
+	NodeSustainabilitiesGetter
 	PodGroupsGetter
 }
 
@@ -37,10 +39,18 @@ type SchedulingV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SchedulingV1alpha1Client) CarbonSchedules() CarbonScheduleInterface {
+	return newCarbonSchedules(c)
+}
+
 func (c *SchedulingV1alpha1Client) ElasticQuotas(namespace string) ElasticQuotaInterface {
 	return newElasticQuotas(c, namespace)
 }
 
+func (c *SchedulingV1alpha1Client) NodeSustainabilities() NodeSustainabilityInterface {
+	return newNodeSustainabilities(c)
+}
+
 func (c *SchedulingV1alpha1Client) PodGroups(namespace string) PodGroupInterface {
 	return newPodGroups(c, namespace)
 }
@@ -24,8 +24,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// CarbonSchedules returns a CarbonScheduleInformer.
+	CarbonSchedules() CarbonScheduleInformer
 	// ElasticQuotas returns a ElasticQuotaInformer.
 	ElasticQuotas() ElasticQuotaInformer
+	// NodeSustainabilities returns a NodeSustainabilityInformer.
+	NodeSustainabilities() NodeSustainabilityInformer
 	// PodGroups returns a PodGroupInformer.
 	PodGroups() PodGroupInformer
 }
@@ -41,11 +45,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// CarbonSchedules returns a CarbonScheduleInformer.
+func (v *version) CarbonSchedules() CarbonScheduleInformer {
+	return &carbonScheduleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ElasticQuotas returns a ElasticQuotaInformer.
 func (v *version) ElasticQuotas() ElasticQuotaInformer {
 	return &elasticQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NodeSustainabilities returns a NodeSustainabilityInformer.
+func (v *version) NodeSustainabilities() NodeSustainabilityInformer {
+	return &nodeSustainabilityInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // PodGroups returns a PodGroupInformer.
 func (v *version) PodGroups() PodGroupInformer {
 	return &podGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+// CarbonScheduleListerExpansion allows custom methods to be added to
+// CarbonScheduleLister.
+type CarbonScheduleListerExpansion interface{}
+
 // ElasticQuotaListerExpansion allows custom methods to be added to
 // ElasticQuotaLister.
 type ElasticQuotaListerExpansion interface{}
@@ -26,6 +30,10 @@ type ElasticQuotaListerExpansion interface{}
 // ElasticQuotaNamespaceLister.
 type ElasticQuotaNamespaceListerExpansion interface{}
 
+// NodeSustainabilityListerExpansion allows custom methods to be added to
+// NodeSustainabilityLister.
+type NodeSustainabilityListerExpansion interface{}
+
 // PodGroupListerExpansion allows custom methods to be added to
 // PodGroupLister.
 type PodGroupListerExpansion interface{}
@@ -0,0 +1,214 @@
+package carbonprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework/parallelize"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// Placeholders substituted in the URL template of the HTTPJSONProvider.
+const (
+	keyPlaceholder       = "{key}"
+	startTimePlaceholder = "{startTime}"
+	endTimePlaceholder   = "{endTime}"
+)
+
+// HTTPJSONProvider provides carbon data from a generic HTTP endpoint returning a JSON document
+// per node. The location of every value within the document is configured by field mappings.
+type HTTPJSONProvider struct {
+	urlTemplate   string
+	token         string
+	fieldMappings config.HTTPJSONFieldMappings
+	httpClient    *http.Client
+	parallelizer  parallelize.Parallelizer
+}
+
+var _ CarbonDataProvider = &HTTPJSONProvider{}
+
+// NewHTTPJSONProvider creates an HTTPJSONProvider from the given spec.
+func NewHTTPJSONProvider(spec *config.HTTPJSONProviderSpec, parallelizer parallelize.Parallelizer) *HTTPJSONProvider {
+	return &HTTPJSONProvider{
+		urlTemplate:   spec.URL,
+		token:         spec.Token,
+		fieldMappings: spec.FieldMappings,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		parallelizer:  parallelizer,
+	}
+}
+
+// GetCarbonData queries the endpoint once per key, in parallel. Keys for which the endpoint
+// responds with 404 Not Found are considered unknown.
+func (p *HTTPJSONProvider) GetCarbonData(ctx context.Context, keys []string, startTime, endTime time.Time) (map[string]CarbonData, error) {
+	fetched := make([]*CarbonData, len(keys))
+	errCh := parallelize.NewErrorChannel()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p.parallelizer.Until(ctx, len(keys), func(i int) {
+		data, err := p.fetch(ctx, keys[i], startTime, endTime)
+		if err != nil {
+			errCh.SendErrorWithCancel(fmt.Errorf("error fetching carbon data for key %s: %w", keys[i], err), cancel)
+			return
+		}
+		fetched[i] = data
+	}, "GreenScheduling")
+	if err := errCh.ReceiveError(); err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]CarbonData, len(keys))
+	for i, key := range keys {
+		if fetched[i] != nil {
+			data[key] = *fetched[i]
+		}
+	}
+	return data, nil
+}
+
+// fetch retrieves and decodes the carbon data of a single key. It returns nil if the key is unknown.
+func (p *HTTPJSONProvider) fetch(ctx context.Context, key string, startTime, endTime time.Time) (*CarbonData, error) {
+	apiURL := strings.NewReplacer(
+		keyPlaceholder, url.QueryEscape(key),
+		startTimePlaceholder, url.QueryEscape(startTime.Format(time.RFC3339)),
+		endTimePlaceholder, url.QueryEscape(endTime.Format(time.RFC3339)),
+	).Replace(p.urlTemplate)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.token))
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("endpoint returned status %s: %s", resp.Status, string(body))
+	}
+
+	var document interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return p.decode(document)
+}
+
+// decode extracts the carbon data from a JSON document using the configured field mappings.
+func (p *HTTPJSONProvider) decode(document interface{}) (*CarbonData, error) {
+	var data CarbonData
+	var err error
+	if data.TotalCo2, err = lookupFloat(document, p.fieldMappings.TotalCO2); err != nil {
+		return nil, err
+	}
+	if data.TotalCost, err = lookupFloat(document, p.fieldMappings.TotalCost); err != nil {
+		return nil, err
+	}
+	if data.TotalKwh, err = lookupFloat(document, p.fieldMappings.TotalKwh); err != nil {
+		return nil, err
+	}
+
+	series, found := lookup(document, p.fieldMappings.Series)
+	if !found || series == nil {
+		return &data, nil
+	}
+	items, ok := series.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field %q is not an array", p.fieldMappings.Series)
+	}
+	for _, item := range items {
+		timestamp, err := lookupTime(item, p.fieldMappings.Time)
+		if err != nil {
+			return nil, err
+		}
+		co2, err := lookupFloat(item, p.fieldMappings.CO2)
+		if err != nil {
+			return nil, err
+		}
+		kwh, err := lookupFloat(item, p.fieldMappings.Kwh)
+		if err != nil {
+			return nil, err
+		}
+		data.Emissions = append(data.Emissions, sustainabilityprofile.NewEmissionDataPoint(co2, kwh, timestamp))
+	}
+	return &data, nil
+}
+
+// lookup resolves a dot-separated path within a decoded JSON document.
+func lookup(document interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return nil, false
+	}
+	current := document
+	for _, field := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[field]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// lookupFloat resolves a number, or a string holding a number, at the given path.
+// Missing or null values resolve to 0.
+func lookupFloat(document interface{}, path string) (float64, error) {
+	value, found := lookup(document, path)
+	if !found || value == nil {
+		return 0, nil
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("field %q is not a number: %w", path, err)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("field %q is not a number", path)
+	}
+}
+
+// lookupTime resolves an RFC3339 timestamp, or a number of seconds since the Unix epoch, at the given path.
+func lookupTime(document interface{}, path string) (time.Time, error) {
+	value, found := lookup(document, path)
+	if !found {
+		return time.Time{}, fmt.Errorf("field %q not found", path)
+	}
+	switch v := value.(type) {
+	case float64:
+		return time.Unix(int64(v), 0).UTC(), nil
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("field %q is not an RFC3339 timestamp: %w", path, err)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("field %q is not a timestamp", path)
+	}
+}
@@ -0,0 +1,101 @@
+package carbonprovider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
+)
+
+// locationKeyPrefix prefixes the keys of locations, so that they never collide with serial numbers.
+const locationKeyPrefix = "location/"
+
+// Location identifies a site by the value of a SIC location filter, e.g. the city "Berlin".
+type Location struct {
+	FilterKey sicparams.FilterKey
+	Value     string
+}
+
+// Key returns the key of the location, e.g. "location/locationCity=Berlin", under which its carbon
+// data is cached alongside the carbon data of serial numbers.
+func (l Location) Key() string {
+	return locationKeyPrefix + string(l.FilterKey) + "=" + l.Value
+}
+
+// ParseLocationKey returns the location of a key returned by Location.Key, or false if the key is
+// not a location key, e.g. because it is a serial number.
+func ParseLocationKey(key string) (Location, bool) {
+	filter, ok := strings.CutPrefix(key, locationKeyPrefix)
+	if !ok {
+		return Location{}, false
+	}
+	filterKey, value, ok := strings.Cut(filter, "=")
+	if !ok {
+		return Location{}, false
+	}
+	return Location{FilterKey: sicparams.FilterKey(filterKey), Value: value}, true
+}
+
+// LocationCarbonDataProvider is implemented by providers able to report carbon data aggregated
+// per location, which nodes without a serial number fall back to.
+type LocationCarbonDataProvider interface {
+	// GetLocationCarbonData returns the carbon data between startTime and endTime of a typical
+	// node at each of the given locations, i.e. averaged over the entities at the location.
+	// Locations unknown to the provider are omitted from the returned map.
+	GetLocationCarbonData(ctx context.Context, locations []Location, startTime, endTime time.Time) (map[Location]CarbonData, error)
+}
+
+// LocationFallback maps the zone or region label of nodes without the serial number label to SIC
+// locations. Empty filter keys disable the fallback.
+type LocationFallback struct {
+	ZoneFilterKey   sicparams.FilterKey
+	RegionFilterKey sicparams.FilterKey
+	Locations       map[string]string
+}
+
+// NewLocationFallback creates the LocationFallback configured in the plugin arguments.
+func NewLocationFallback(spec *config.LocationFallbackSpec) LocationFallback {
+	return LocationFallback{
+		ZoneFilterKey:   sicparams.FilterKey(spec.ZoneFilterKey),
+		RegionFilterKey: sicparams.FilterKey(spec.RegionFilterKey),
+		Locations:       spec.Locations,
+	}
+}
+
+// NodeLocation maps the zone label of a node, or else its region label, to a SIC location.
+// Label values without a configured mapping are used as the location as is.
+func (f LocationFallback) NodeLocation(labels map[string]string) (Location, bool) {
+	for _, topology := range []struct {
+		label     string
+		filterKey sicparams.FilterKey
+	}{
+		{label: v1.LabelTopologyZone, filterKey: f.ZoneFilterKey},
+		{label: v1.LabelTopologyRegion, filterKey: f.RegionFilterKey},
+	} {
+		value, ok := labels[topology.label]
+		if topology.filterKey == "" || !ok || value == "" {
+			continue
+		}
+		if location, ok := f.Locations[value]; ok {
+			value = location
+		}
+		return Location{FilterKey: topology.filterKey, Value: value}, true
+	}
+	return Location{}, false
+}
+
+// NodeKey returns the key of the carbon data of a node with the given labels: the value of its
+// serial number label or, for nodes without it, the key of the location it falls back to.
+func NodeKey(labels map[string]string, serialNumLabel string, fallback LocationFallback) (string, bool) {
+	if serialNum, ok := labels[serialNumLabel]; ok {
+		return serialNum, true
+	}
+	if location, ok := fallback.NodeLocation(labels); ok {
+		return location.Key(), true
+	}
+	return "", false
+}
@@ -0,0 +1,161 @@
+package carbonprovider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned"
+	schedinformers "sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+	schedlisters "sigs.k8s.io/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// informerSyncTimeout bounds the wait for the informers of providers to sync, which never happens
+// if the resources they watch do not exist or the scheduler is not allowed to list and watch them.
+var informerSyncTimeout = time.Minute
+
+// NodeSustainabilityProvider provides the carbon data stored in NodeSustainability objects by
+// the NodeSustainability controller, so that scheduler replicas do not query the carbon data
+// source on their own. Objects are matched by the key in their spec.
+type NodeSustainabilityProvider struct {
+	lister schedlisters.NodeSustainabilityLister
+}
+
+var _ CarbonDataProvider = &NodeSustainabilityProvider{}
+var _ LocationCarbonDataProvider = &NodeSustainabilityProvider{}
+
+// NewNodeSustainabilityProvider creates a NodeSustainabilityProvider reading NodeSustainability
+// objects through a dedicated informer, running until the context is done.
+func NewNodeSustainabilityProvider(ctx context.Context, kubeConfig *rest.Config) (*NodeSustainabilityProvider, error) {
+	client, err := versioned.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating scheduling client: %w", err)
+	}
+	informerFactory := schedinformers.NewSharedInformerFactory(client, 0)
+	informer := informerFactory.Scheduling().V1alpha1().NodeSustainabilities()
+	lister := informer.Lister()
+
+	informerFactory.Start(ctx.Done())
+	syncCtx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), informer.Informer().HasSynced) {
+		return nil, fmt.Errorf("timed out after %v waiting for the NodeSustainability informer to sync, check that the NodeSustainability CRD is installed and the scheduler is allowed to list and watch NodeSustainabilities", informerSyncTimeout)
+	}
+	return &NodeSustainabilityProvider{lister: lister}, nil
+}
+
+// GetCarbonData returns the carbon data of the NodeSustainability objects with the given keys.
+// Objects whose status has not been populated yet are omitted.
+func (p *NodeSustainabilityProvider) GetCarbonData(ctx context.Context, keys []string, startTime, endTime time.Time) (map[string]CarbonData, error) {
+	data := make(map[string]CarbonData, len(keys))
+	if len(keys) == 0 {
+		return data, nil
+	}
+
+	objects, err := p.lister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing NodeSustainabilities: %w", err)
+	}
+	wanted := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		wanted[key] = true
+	}
+	for _, object := range objects {
+		if !wanted[object.Spec.Key] || object.Status.LastUpdateTime == nil {
+			continue
+		}
+		data[object.Spec.Key] = NewCarbonDataFromStatus(&object.Status, startTime, endTime)
+	}
+	return data, nil
+}
+
+// GetLocationCarbonData returns the carbon data of the NodeSustainability objects of nodes that
+// fall back to the given locations.
+func (p *NodeSustainabilityProvider) GetLocationCarbonData(ctx context.Context, locations []Location, startTime, endTime time.Time) (map[Location]CarbonData, error) {
+	keys := make([]string, len(locations))
+	for i, location := range locations {
+		keys[i] = location.Key()
+	}
+	byKey, err := p.GetCarbonData(ctx, keys, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[Location]CarbonData, len(byKey))
+	for _, location := range locations {
+		if locationData, ok := byKey[location.Key()]; ok {
+			data[location] = locationData
+		}
+	}
+	return data, nil
+}
+
+// NewCarbonDataFromStatus converts the status of a NodeSustainability object to carbon data,
+// keeping the emission data points between startTime and endTime.
+func NewCarbonDataFromStatus(status *schedv1alpha1.NodeSustainabilityStatus, startTime, endTime time.Time) CarbonData {
+	data := CarbonData{
+		TotalCo2:  status.TotalCO2.AsApproximateFloat64(),
+		TotalCost: status.TotalCost.AsApproximateFloat64(),
+		TotalKwh:  status.TotalKwh.AsApproximateFloat64(),
+		Hardware: sustainabilityprofile.Hardware{
+			Make:  status.Hardware.Make,
+			Model: status.Hardware.Model,
+		},
+	}
+	if status.Hardware.ManufactureTime != nil {
+		data.Hardware.ManufacturedAt = status.Hardware.ManufactureTime.Time
+	}
+	for _, sample := range status.Series {
+		if sample.Time.Time.Before(startTime) || sample.Time.Time.After(endTime) {
+			continue
+		}
+		data.Emissions = append(data.Emissions, sustainabilityprofile.NewEmissionDataPoint(
+			sample.CO2.AsApproximateFloat64(),
+			sample.Kwh.AsApproximateFloat64(),
+			sample.Time.Time,
+		))
+	}
+	return data
+}
+
+// NewNodeSustainabilityStatus converts a sustainability profile, and the score calculated from
+// it, to the status of a NodeSustainability object updated at the given time.
+func NewNodeSustainabilityStatus(profile sustainabilityprofile.SustainabilityProfile, score float64, updatedAt time.Time) schedv1alpha1.NodeSustainabilityStatus {
+	status := schedv1alpha1.NodeSustainabilityStatus{
+		TotalCO2:  newQuantity(profile.TotalCo2),
+		TotalCost: newQuantity(profile.TotalCost),
+		TotalKwh:  newQuantity(profile.TotalKwh),
+		Hardware: schedv1alpha1.NodeHardware{
+			Make:  profile.Hardware.Make,
+			Model: profile.Hardware.Model,
+		},
+		Score:          newQuantity(score),
+		LastUpdateTime: &metav1.Time{Time: updatedAt},
+	}
+	if !profile.Hardware.ManufacturedAt.IsZero() {
+		status.Hardware.ManufactureTime = &metav1.Time{Time: profile.Hardware.ManufacturedAt}
+	}
+	for _, point := range profile.Emissions {
+		status.Series = append(status.Series, schedv1alpha1.EmissionSample{
+			Time: metav1.Time{Time: point.Time},
+			CO2:  newQuantity(point.Co2),
+			Kwh:  newQuantity(point.Kwh),
+		})
+	}
+	return status
+}
+
+// newQuantity converts a value to a quantity with nano precision, which preserves the small
+// hourly CO2 emissions reported in metric tons.
+func newQuantity(value float64) resource.Quantity {
+	return *resource.NewScaledQuantity(int64(math.Round(value*1e9)), resource.Nano)
+}
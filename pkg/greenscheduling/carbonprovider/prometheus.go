@@ -0,0 +1,167 @@
+package carbonprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	promconfig "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework/parallelize"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+const (
+	// stepPlaceholder is substituted with the query resolution in the energy query of the
+	// PrometheusProvider, along with keyPlaceholder.
+	stepPlaceholder = "{step}"
+
+	// joulesPerKwh converts energy in joules to kWh.
+	joulesPerKwh = 3.6e6
+	// gramsPerMetricTon converts emissions in grams to metric tons.
+	gramsPerMetricTon = 1e6
+)
+
+// PrometheusProvider provides carbon data from node energy metrics stored in Prometheus, e.g. the
+// node power counters exported by Kepler. The energy consumed during every step of a range query
+// is converted to CO₂ emissions using the grid carbon intensity of the node's region.
+// Prometheus holds no cost data, so the total cost of every node is 0.
+type PrometheusProvider struct {
+	api                    promv1.API
+	energyQuery            string
+	step                   time.Duration
+	regionLabel            model.LabelName
+	carbonIntensity        map[string]float64
+	defaultCarbonIntensity float64
+	parallelizer           parallelize.Parallelizer
+}
+
+var _ CarbonDataProvider = &PrometheusProvider{}
+
+// NewPrometheusProvider creates a PrometheusProvider from the given spec.
+func NewPrometheusProvider(spec *config.PrometheusProviderSpec, parallelizer parallelize.Parallelizer) (*PrometheusProvider, error) {
+	roundTripper := promapi.DefaultRoundTripper
+	if spec.Token != "" {
+		roundTripper = promconfig.NewAuthorizationCredentialsRoundTripper("Bearer", promconfig.Secret(spec.Token), roundTripper)
+	}
+	client, err := promapi.NewClient(promapi.Config{
+		Address:      spec.Address,
+		RoundTripper: roundTripper,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating Prometheus client: %w", err)
+	}
+
+	return &PrometheusProvider{
+		api:                    promv1.NewAPI(client),
+		energyQuery:            spec.EnergyQuery,
+		step:                   time.Duration(spec.StepSeconds) * time.Second,
+		regionLabel:            model.LabelName(spec.RegionLabel),
+		carbonIntensity:        spec.CarbonIntensity,
+		defaultCarbonIntensity: spec.DefaultCarbonIntensity,
+		parallelizer:           parallelizer,
+	}, nil
+}
+
+// GetCarbonData runs the energy query once per key, in parallel. Keys for which the query
+// returns no series are considered unknown.
+func (p *PrometheusProvider) GetCarbonData(ctx context.Context, keys []string, startTime, endTime time.Time) (map[string]CarbonData, error) {
+	fetched := make([]*CarbonData, len(keys))
+	errCh := parallelize.NewErrorChannel()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p.parallelizer.Until(ctx, len(keys), func(i int) {
+		data, err := p.query(ctx, keys[i], startTime, endTime)
+		if err != nil {
+			errCh.SendErrorWithCancel(fmt.Errorf("error querying energy data for key %s: %w", keys[i], err), cancel)
+			return
+		}
+		fetched[i] = data
+	}, "GreenScheduling")
+	if err := errCh.ReceiveError(); err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]CarbonData, len(keys))
+	for i, key := range keys {
+		if fetched[i] != nil {
+			data[key] = *fetched[i]
+		}
+	}
+	return data, nil
+}
+
+// query runs the energy query for a single key and converts its result into carbon data.
+// It returns nil if the query returns no series.
+func (p *PrometheusProvider) query(ctx context.Context, key string, startTime, endTime time.Time) (*CarbonData, error) {
+	query := strings.NewReplacer(
+		keyPlaceholder, escapeLabelValue(key),
+		stepPlaceholder, model.Duration(p.step).String(),
+	).Replace(p.energyQuery)
+
+	value, warnings, err := p.api.QueryRange(ctx, query, promv1.Range{Start: startTime, End: endTime, Step: p.step})
+	if err != nil {
+		return nil, err
+	}
+	for _, warning := range warnings {
+		klog.Warningf("Prometheus warning for query %q: %s", query, warning)
+	}
+
+	matrix, ok := value.(model.Matrix)
+	if !ok {
+		return nil, fmt.Errorf("expected a matrix result, got %s", value.Type())
+	}
+	if len(matrix) == 0 {
+		return nil, nil
+	}
+
+	// Sum up the energy and emissions of all series, which may cover different regions, per timestamp.
+	var data CarbonData
+	emissions := make(map[model.Time]*sustainabilityprofile.EmissionDataPoint)
+	for _, series := range matrix {
+		intensity := p.intensity(string(series.Metric[p.regionLabel]))
+		for _, sample := range series.Values {
+			kwh := float64(sample.Value) / joulesPerKwh
+			co2 := kwh * intensity / gramsPerMetricTon
+			emission, ok := emissions[sample.Timestamp]
+			if !ok {
+				emission = &sustainabilityprofile.EmissionDataPoint{Time: sample.Timestamp.Time().UTC()}
+				emissions[sample.Timestamp] = emission
+			}
+			emission.Co2 += co2
+			emission.Kwh += kwh
+			data.TotalKwh += kwh
+			data.TotalCo2 += co2
+		}
+	}
+
+	timestamps := make([]model.Time, 0, len(emissions))
+	for timestamp := range emissions {
+		timestamps = append(timestamps, timestamp)
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+	for _, timestamp := range timestamps {
+		data.Emissions = append(data.Emissions, *emissions[timestamp])
+	}
+	return &data, nil
+}
+
+// intensity returns the grid carbon intensity, in gCO2e/kWh, of the given region.
+func (p *PrometheusProvider) intensity(region string) float64 {
+	if intensity, ok := p.carbonIntensity[region]; ok {
+		return intensity
+	}
+	return p.defaultCarbonIntensity
+}
+
+// escapeLabelValue escapes a value to be placed within a double-quoted PromQL label matcher.
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
@@ -0,0 +1,62 @@
+// Package carbonprovider defines the source of the carbon data the GreenScheduling plugin scores
+// nodes with, and provides implementations backed by the Sustainability Insight Center (SIC),
+// a static file such as a mounted ConfigMap, a generic HTTP/JSON endpoint, and node energy
+// metrics stored in Prometheus, or the NodeSustainability objects maintained by its controller.
+package carbonprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubernetes/pkg/scheduler/framework/parallelize"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// CarbonData holds the sustainability data of a single node over the requested time range.
+type CarbonData struct {
+	Emissions []sustainabilityprofile.EmissionDataPoint // Emission time series
+	TotalCo2  float64                                   // Total CO₂ emissions (metric tons)
+	TotalCost float64                                   // Total cost (USD)
+	TotalKwh  float64                                   // Total energy consumption (kWh)
+	Hardware  sustainabilityprofile.Hardware            // Hardware of the node, if known
+}
+
+// CarbonDataProvider is a source of per-node carbon data.
+type CarbonDataProvider interface {
+	// GetCarbonData returns the carbon data between startTime and endTime for the given keys,
+	// the values of the nodes' serial number label. Keys unknown to the provider are omitted
+	// from the returned map.
+	GetCarbonData(ctx context.Context, keys []string, startTime, endTime time.Time) (map[string]CarbonData, error)
+}
+
+// Handle provides the clients and parallelizer providers are created with. It is implemented by
+// the scheduler framework's handle, as well as by the NodeSustainability controller.
+type Handle interface {
+	ClientSet() kubernetes.Interface
+	KubeConfig() *rest.Config
+	Parallelizer() parallelize.Parallelizer
+}
+
+// New creates the CarbonDataProvider selected in the plugin arguments. Any background work
+// of the provider, such as watching credentials, stops when the context is done.
+func New(ctx context.Context, args *config.GreenSchedulingArgs, handle Handle) (CarbonDataProvider, error) {
+	switch args.Provider {
+	case config.SICCarbonDataProvider:
+		return NewSICProvider(ctx, args, handle)
+	case config.StaticFileCarbonDataProvider:
+		return NewStaticFileProvider(&args.StaticFileProvider), nil
+	case config.HTTPJSONCarbonDataProvider:
+		return NewHTTPJSONProvider(&args.HTTPJSONProvider, handle.Parallelizer()), nil
+	case config.PrometheusCarbonDataProvider:
+		return NewPrometheusProvider(&args.PrometheusProvider, handle.Parallelizer())
+	case config.NodeSustainabilityCarbonDataProvider:
+		return NewNodeSustainabilityProvider(ctx, handle.KubeConfig())
+	default:
+		return nil, fmt.Errorf("invalid carbon data provider %q", args.Provider)
+	}
+}
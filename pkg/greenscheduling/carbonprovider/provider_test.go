@@ -0,0 +1,410 @@
+package carbonprovider
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/scheduler/framework/parallelize"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedlisters "sigs.k8s.io/scheduler-plugins/pkg/generated/listers/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicfake"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+)
+
+var (
+	testStartTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testEndTime   = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func TestStaticFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "carbon.yaml")
+	content := `nodes:
+  SN1:
+    totalCO2: 1.5
+    totalCost: 200
+    totalKwh: 3000
+    emissions:
+    - time: "2023-12-31T00:00:00Z"
+      co2: 9
+    - time: "2024-01-01T12:00:00Z"
+      co2: 0.25
+      kwh: 12
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewStaticFileProvider(&config.StaticFileProviderSpec{Path: path})
+	data, err := p.GetCarbonData(context.Background(), []string{"SN1", "SN2"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := data["SN2"]; ok {
+		t.Errorf("expected no data for a key missing from the file")
+	}
+	got, ok := data["SN1"]
+	if !ok {
+		t.Fatalf("expected data for SN1")
+	}
+	if got.TotalCo2 != 1.5 || got.TotalCost != 200 || got.TotalKwh != 3000 {
+		t.Errorf("unexpected totals: %+v", got)
+	}
+	if len(got.Emissions) != 1 || got.Emissions[0].Co2 != 0.25 || got.Emissions[0].Kwh != 12 {
+		t.Errorf("expected only the emission within the time range, got %+v", got.Emissions)
+	}
+}
+
+func TestHTTPJSONProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("from") != testStartTime.Format(time.RFC3339) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/nodes/SN1":
+			w.Write([]byte(`{"summary": {"co2": 2, "cost": "10.5", "kwh": 40}, "points": [{"ts": "2024-01-01T06:00:00Z", "value": 0.5}, {"ts": 1704096000, "value": 0.75}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	p := NewHTTPJSONProvider(&config.HTTPJSONProviderSpec{
+		URL:   server.URL + "/nodes/{key}?from={startTime}&to={endTime}",
+		Token: "secret",
+		FieldMappings: config.HTTPJSONFieldMappings{
+			Series:    "points",
+			Time:      "ts",
+			CO2:       "value",
+			TotalCO2:  "summary.co2",
+			TotalCost: "summary.cost",
+			TotalKwh:  "summary.kwh",
+		},
+	}, parallelize.NewParallelizer(2))
+
+	data, err := p.GetCarbonData(context.Background(), []string{"SN1", "SN2"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := data["SN2"]; ok {
+		t.Errorf("expected no data for a key unknown to the endpoint")
+	}
+	got, ok := data["SN1"]
+	if !ok {
+		t.Fatalf("expected data for SN1")
+	}
+	if got.TotalCo2 != 2 || got.TotalCost != 10.5 || got.TotalKwh != 40 {
+		t.Errorf("unexpected totals: %+v", got)
+	}
+	if len(got.Emissions) != 2 {
+		t.Fatalf("expected 2 emission data points, got %d", len(got.Emissions))
+	}
+	if want := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC); !got.Emissions[1].Time.Equal(want) {
+		t.Errorf("expected Unix timestamp to be parsed as %v, got %v", want, got.Emissions[1].Time)
+	}
+}
+
+func TestPrometheusProvider(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		query := r.Form.Get("query")
+		queries = append(queries, query)
+		w.Header().Set("Content-Type", "application/json")
+		if query != `sum by (region) (increase(node_joules_total{instance="node-1"}[1h]))` {
+			w.Write([]byte(`{"status": "success", "data": {"resultType": "matrix", "result": []}}`))
+			return
+		}
+		// 3.6 MJ (1 kWh) per hour in eu-west, 7.2 MJ (2 kWh) per hour in an unknown region.
+		w.Write([]byte(`{"status": "success", "data": {"resultType": "matrix", "result": [
+			{"metric": {"region": "eu-west"}, "values": [[1704067200, "3600000"], [1704070800, "3600000"]]},
+			{"metric": {"region": "unknown"}, "values": [[1704070800, "7200000"]]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPrometheusProvider(&config.PrometheusProviderSpec{
+		Address:                server.URL,
+		EnergyQuery:            `sum by (region) (increase(node_joules_total{instance="{key}"}[{step}]))`,
+		StepSeconds:            3600,
+		RegionLabel:            "region",
+		CarbonIntensity:        map[string]float64{"eu-west": 100},
+		DefaultCarbonIntensity: 500,
+	}, parallelize.NewParallelizer(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := p.GetCarbonData(context.Background(), []string{"node-1", `node-"2"`}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := data[`node-"2"`]; ok {
+		t.Errorf("expected no data for a key without series")
+	}
+	if len(queries) != 2 || queries[1] != `sum by (region) (increase(node_joules_total{instance="node-\"2\""}[1h]))` {
+		t.Errorf("expected the key to be escaped in the query, got %q", queries)
+	}
+	got, ok := data["node-1"]
+	if !ok {
+		t.Fatalf("expected data for node-1")
+	}
+	if got.TotalKwh != 4 {
+		t.Errorf("expected 4 kWh, got %v", got.TotalKwh)
+	}
+	// 2 kWh at 100 g/kWh and 2 kWh at 500 g/kWh.
+	if want := 0.0012; math.Abs(got.TotalCo2-want) > 1e-12 {
+		t.Errorf("expected %v t of CO2, got %v", want, got.TotalCo2)
+	}
+	if len(got.Emissions) != 2 {
+		t.Fatalf("expected 2 emission data points, got %d", len(got.Emissions))
+	}
+	if want := 0.0011; math.Abs(got.Emissions[1].Co2-want) > 1e-12 {
+		t.Errorf("expected the emissions of both series to be summed up to %v, got %v", want, got.Emissions[1].Co2)
+	}
+	if got.Emissions[1].Kwh != 3 {
+		t.Errorf("expected the energy of both series to be summed up to 3 kWh, got %v", got.Emissions[1].Kwh)
+	}
+	if !got.Emissions[0].Time.Equal(testStartTime) {
+		t.Errorf("expected first data point at %v, got %v", testStartTime, got.Emissions[0].Time)
+	}
+}
+
+func TestNodeSustainabilityProvider(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	location := Location{FilterKey: sicparams.FilterKeyLocationCity, Value: "Berlin"}
+	for _, ns := range []*schedv1alpha1.NodeSustainability{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+			Spec:       schedv1alpha1.NodeSustainabilitySpec{Key: "SN1"},
+			Status: schedv1alpha1.NodeSustainabilityStatus{
+				TotalCO2:       resource.MustParse("1.5"),
+				Series:         []schedv1alpha1.EmissionSample{{Time: metav1.NewTime(testStartTime.Add(time.Hour)), CO2: resource.MustParse("250m"), Kwh: resource.MustParse("12")}},
+				LastUpdateTime: &metav1.Time{Time: testEndTime},
+			},
+		},
+		{
+			// Not populated by the controller yet.
+			ObjectMeta: metav1.ObjectMeta{Name: "node-b"},
+			Spec:       schedv1alpha1.NodeSustainabilitySpec{Key: "SN2"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-c"},
+			Spec:       schedv1alpha1.NodeSustainabilitySpec{Key: location.Key()},
+			Status:     schedv1alpha1.NodeSustainabilityStatus{TotalCO2: resource.MustParse("2"), LastUpdateTime: &metav1.Time{Time: testEndTime}},
+		},
+	} {
+		if err := indexer.Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p := &NodeSustainabilityProvider{lister: schedlisters.NewNodeSustainabilityLister(indexer)}
+
+	data, err := p.GetCarbonData(context.Background(), []string{"SN1", "SN2", "SN3"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 1 {
+		t.Fatalf("expected only the populated NodeSustainability, got %+v", data)
+	}
+	if got := data["SN1"]; got.TotalCo2 != 1.5 || len(got.Emissions) != 1 || got.Emissions[0].Co2 != 0.25 || got.Emissions[0].Kwh != 12 {
+		t.Errorf("unexpected carbon data: %+v", got)
+	}
+
+	locationData, err := p.GetLocationCarbonData(context.Background(), []Location{location}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, ok := locationData[location]; !ok || got.TotalCo2 != 2 {
+		t.Errorf("expected the carbon data of the location, got %+v", locationData)
+	}
+}
+
+func TestNodeSustainabilityProviderWithoutCRD(t *testing.T) {
+	defer func(timeout time.Duration) { informerSyncTimeout = timeout }(informerSyncTimeout)
+	informerSyncTimeout = 500 * time.Millisecond
+
+	// The API server does not serve NodeSustainabilities, e.g. because the CRD is not installed.
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := NewNodeSustainabilityProvider(ctx, &rest.Config{Host: server.URL}); err == nil {
+		t.Fatalf("expected an error when NodeSustainabilities cannot be listed")
+	}
+}
+
+// testHandle provides providers with a parallelizer and no clients.
+type testHandle struct{}
+
+func (testHandle) ClientSet() kubernetes.Interface        { return nil }
+func (testHandle) KubeConfig() *rest.Config               { return nil }
+func (testHandle) Parallelizer() parallelize.Parallelizer { return parallelize.NewParallelizer(2) }
+
+func TestSICProvider(t *testing.T) {
+	berlin := "Berlin"
+	float := func(value float64) *float64 { return &value }
+	series := func(co2 ...float64) []sicresponse.UsageSeriesItem {
+		var items []sicresponse.UsageSeriesItem
+		for i, value := range co2 {
+			items = append(items, sicresponse.UsageSeriesItem{TimeBucket: testStartTime.Add(time.Duration(i) * time.Hour).Format(time.RFC3339), Co2eMetricTon: float(value)})
+		}
+		return items
+	}
+	server := sicfake.NewServer(
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN1", Co2eMetricTon: float(1), EntityMake: "HPE"}, Series: series(0.25, 0.75)},
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN2", Co2eMetricTon: float(2), LocationCity: &berlin}, Series: series(1)},
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN3", Co2eMetricTon: float(4), LocationCity: &berlin}, Series: series(3)},
+		// SN5 is reported as two entities, e.g. a server and its enclosure.
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN5", Co2eMetricTon: float(2)}, Series: series(1)},
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN5", Co2eMetricTon: float(4), EntityMake: "HPE"}, Series: series(3)},
+		// SN6 is reported as two entities consuming different amounts of energy.
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN6", Co2eMetricTon: float(2), Kwh: float(1)}, Series: series(3)},
+		sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntitySerialNum: "SN6", Co2eMetricTon: float(4), Kwh: float(3)}, Series: series(9)},
+	)
+	defer server.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := server.WriteCAFile(caFile); err != nil {
+		t.Fatal(err)
+	}
+	// The first usage series request fails and is retried.
+	server.InjectFaults(sicfake.EndpointUsageSeries, sicfake.Fault{StatusCode: http.StatusServiceUnavailable})
+
+	args := &config.GreenSchedulingArgs{
+		TokenURL:           server.TokenURL(),
+		ClientID:           sicfake.ClientID,
+		ClientSecret:       sicfake.ClientSecret,
+		SICBaseURL:         server.BaseURL(),
+		SICCAFile:          caFile,
+		TimeSeriesInterval: "1 hour",
+		EntityAggregation:  config.SumEntityAggregation,
+	}
+	p, err := NewSICProvider(context.Background(), args, testHandle{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := p.GetCarbonData(context.Background(), []string{"SN1", "SN4"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 1 {
+		t.Fatalf("expected only the carbon data of the entity known to SIC, got %+v", data)
+	}
+	if got := data["SN1"]; got.TotalCo2 != 1 || got.Hardware.Make != "HPE" || len(got.Emissions) != 2 || got.Emissions[1].Co2 != 0.75 {
+		t.Errorf("unexpected carbon data: %+v", got)
+	}
+	if got := server.Requests(sicfake.EndpointUsageSeries); got != 2 {
+		t.Errorf("expected the failed usage series request to be retried once, got %d requests", got)
+	}
+
+	location := Location{FilterKey: sicparams.FilterKeyLocationCity, Value: berlin}
+	locationData, err := p.GetLocationCarbonData(context.Background(), []Location{location}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Both totals and series are averaged over the two entities in Berlin.
+	if got := locationData[location]; got.TotalCo2 != 3 || len(got.Emissions) != 1 || got.Emissions[0].Co2 != 2 {
+		t.Errorf("expected the averaged carbon data of the location, got %+v", locationData)
+	}
+
+	// The entities of a serial number are summed, or averaged if so configured.
+	data, err = p.GetCarbonData(context.Background(), []string{"SN5"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := data["SN5"]; got.TotalCo2 != 6 || got.Hardware.Make != "HPE" || len(got.Emissions) != 1 || got.Emissions[0].Co2 != 4 {
+		t.Errorf("expected the summed carbon data of SN5, got %+v", got)
+	}
+	args.EntityAggregation = config.AverageEntityAggregation
+	p, err = NewSICProvider(context.Background(), args, testHandle{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err = p.GetCarbonData(context.Background(), []string{"SN5"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := data["SN5"]; got.TotalCo2 != 3 || len(got.Emissions) != 1 || got.Emissions[0].Co2 != 2 {
+		t.Errorf("expected the averaged carbon data of SN5, got %+v", got)
+	}
+
+	// The average is weighted by the energy consumption of the entities.
+	data, err = p.GetCarbonData(context.Background(), []string{"SN6"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := data["SN6"]; got.TotalCo2 != 3.5 || got.TotalKwh != 2.5 || len(got.Emissions) != 1 || math.Abs(got.Emissions[0].Co2-7) > 1e-9 {
+		t.Errorf("expected the weighted average carbon data of SN6, got %+v", got)
+	}
+
+	// Serial numbers are queried in chunks, and those whose series cannot be fetched are left out.
+	defer func(n int) { serialNumsPerQuery = n }(serialNumsPerQuery)
+	serialNumsPerQuery = 2
+	usageByEntityRequests := server.Requests(sicfake.EndpointUsageByEntity)
+	server.InjectFaults(sicfake.EndpointUsageSeries, sicfake.Fault{StatusCode: http.StatusBadRequest})
+	data, err = p.GetCarbonData(context.Background(), []string{"SN1", "SN2", "SN3"}, testStartTime, testEndTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 2 {
+		t.Errorf("expected the carbon data of the two serial numbers whose series were fetched, got %+v", data)
+	}
+	if got := server.Requests(sicfake.EndpointUsageByEntity) - usageByEntityRequests; got != 2 {
+		t.Errorf("expected a usage by entity request per chunk of serial numbers, got %d requests", got)
+	}
+
+	// An error is returned if no serial number could be fetched.
+	server.InjectFaults(sicfake.EndpointUsageSeries, sicfake.Fault{StatusCode: http.StatusBadRequest})
+	if _, err := p.GetCarbonData(context.Background(), []string{"SN1"}, testStartTime, testEndTime); err == nil {
+		t.Errorf("expected an error when no usage series could be fetched")
+	}
+}
+
+func TestClientSecretSourceForbidden(t *testing.T) {
+	defer func(timeout time.Duration) { informerSyncTimeout = timeout }(informerSyncTimeout)
+	informerSyncTimeout = 500 * time.Millisecond
+
+	// Without RBAC for the Secret, its informer never syncs.
+	clientSet := fake.NewSimpleClientset()
+	clientSet.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(action.GetResource().GroupResource(), "", nil)
+	})
+	args := &config.GreenSchedulingArgs{ClientSecretRef: config.SecretKeyRef{Namespace: "kube-system", Name: "sic", Key: "clientSecret"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := newClientSecretSource(ctx, args, clientSet); err == nil {
+		t.Fatalf("expected an error when the Secret cannot be listed")
+	}
+}
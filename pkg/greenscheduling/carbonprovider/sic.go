@@ -0,0 +1,354 @@
+package carbonprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework/parallelize"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// SICProvider provides carbon data from the Sustainability Insight Center (SIC) API,
+// using the node serial numbers as entity serial numbers.
+type SICProvider struct {
+	client            *sicclient.Client
+	seriesInterval    string
+	entityAggregation config.EntityAggregationType
+	parallelizer      parallelize.Parallelizer
+}
+
+var _ CarbonDataProvider = &SICProvider{}
+var _ LocationCarbonDataProvider = &SICProvider{}
+
+// NewSICProvider creates a SICProvider authenticating with the credentials in the plugin arguments.
+func NewSICProvider(ctx context.Context, args *config.GreenSchedulingArgs, handle Handle) (*SICProvider, error) {
+	clientSecret, err := newClientSecretSource(ctx, args, handle.ClientSet())
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the token configuration to authenticate with the Sustainability Information Center (SIC).
+	tokenConfig := sicclient.TokenConfig{
+		URL:          args.TokenURL,
+		ClientID:     args.ClientID,
+		ClientSecret: clientSecret,
+	}
+
+	var httpClient *http.Client
+	if args.SICCAFile != "" {
+		if httpClient, err = sicclient.NewHTTPClient(args.SICCAFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SICProvider{
+		client: sicclient.New(sicclient.Config{
+			Hostname:    args.SICHostname,
+			BaseURL:     args.SICBaseURL,
+			HTTPClient:  httpClient,
+			TokenConfig: tokenConfig,
+		}),
+		seriesInterval:    args.TimeSeriesInterval,
+		entityAggregation: args.EntityAggregation,
+		parallelizer:      handle.Parallelizer(),
+	}, nil
+}
+
+// newClientSecretSource returns the source of the SIC client secret configured in the plugin
+// arguments: a Secret, watched by a dedicated informer limited to that Secret, a file, or the
+// plain text secret in the arguments.
+func newClientSecretSource(ctx context.Context, args *config.GreenSchedulingArgs, clientSet kubernetes.Interface) (sicclient.ClientSecretSource, error) {
+	switch {
+	case args.ClientSecretRef.Name != "":
+		ref := args.ClientSecretRef
+		secretInformer := coreinformers.NewFilteredSecretInformer(clientSet, ref.Namespace, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			func(options *metav1.ListOptions) {
+				options.FieldSelector = fields.OneTermEqualSelector(metav1.ObjectNameField, ref.Name).String()
+			})
+		secretLister := corelisters.NewSecretLister(secretInformer.GetIndexer())
+
+		go secretInformer.Run(ctx.Done())
+		syncCtx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+		defer cancel()
+		if !cache.WaitForCacheSync(syncCtx.Done(), secretInformer.HasSynced) {
+			return nil, fmt.Errorf("timed out after %v waiting for the informer of Secret %s/%s to sync, check that the scheduler is allowed to get, list and watch it", informerSyncTimeout, ref.Namespace, ref.Name)
+		}
+		return sicclient.NewSecretClientSecret(secretLister.Secrets(ref.Namespace), ref.Name, ref.Key), nil
+	case args.ClientSecretFile != "":
+		return sicclient.FileClientSecret{Path: args.ClientSecretFile}, nil
+	default:
+		return sicclient.StaticClientSecret(args.ClientSecret), nil
+	}
+}
+
+// serialNumsPerQuery bounds the number of serial numbers of a single `in` query, keeping its URL
+// within the limits of SIC and of proxies in between.
+var serialNumsPerQuery = 50
+
+// GetCarbonData fetches SIC data for the given serial numbers. Usage by entity is retrieved with
+// a paginated `in` query per chunk of serial numbers, and the usage of all entities matching a
+// serial number is summed or averaged as configured. The usage-series endpoint sums the series
+// of all matched entities and has no way to group them by serial number, so series are fetched
+// once per serial number, in parallel, rather than with a single `in` query. Serial numbers
+// whose data cannot be fetched are left out, and an error is only returned if none could be.
+func (p *SICProvider) GetCarbonData(ctx context.Context, keys []string, startTime, endTime time.Time) (map[string]CarbonData, error) {
+	data := make(map[string]CarbonData, len(keys))
+	if len(keys) == 0 {
+		return data, nil
+	}
+
+	start, end := startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)
+
+	var errs []error
+	usageEntities := make(map[string][]sicresponse.UsageEntity, len(keys))
+	for first := 0; first < len(keys); first += serialNumsPerQuery {
+		chunk := keys[first:min(first+serialNumsPerQuery, len(keys))]
+		filter, err := sicparams.NewFilter(sicparams.FilterKeyEntitySerialNum, sicparams.FilterOperatorIn, chunk)
+		if err != nil {
+			return nil, fmt.Errorf("error creating filter: %w", err)
+		}
+		usageByEntity, err := p.client.GetAllUsageByEntity(ctx, start, end, sicparams.New().AddFilter(filter))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error fetching usage by entity data of %d serial numbers: %w", len(chunk), err))
+			continue
+		}
+		for _, entity := range usageByEntity.Items {
+			usageEntities[entity.EntitySerialNum] = append(usageEntities[entity.EntitySerialNum], entity)
+		}
+	}
+
+	// Only fetch the time series of entities known to SIC.
+	var knownSerialNums []string
+	for _, serialNum := range keys {
+		if _, ok := usageEntities[serialNum]; ok {
+			knownSerialNums = append(knownSerialNums, serialNum)
+		} else if len(errs) == 0 {
+			klog.Warningf("No SIC usage data found for serial number %s", serialNum)
+		}
+	}
+
+	fetched := make([]*CarbonData, len(knownSerialNums))
+	fetchErrs := make([]error, len(knownSerialNums))
+	p.parallelizer.Until(ctx, len(knownSerialNums), func(i int) {
+		serialNum := knownSerialNums[i]
+		params, err := buildSicParams(serialNum)
+		if err != nil {
+			fetchErrs[i] = fmt.Errorf("error creating filter parameters for serial number %s: %w", serialNum, err)
+			return
+		}
+
+		usageSeries, err := p.client.GetUsageSeries(ctx, start, end, p.seriesInterval, params)
+		if err != nil {
+			fetchErrs[i] = fmt.Errorf("error fetching usage series data for serial number %s: %w", serialNum, err)
+			return
+		}
+
+		entityData, err := NewCarbonDataFromSIC(usageEntities[serialNum], usageSeries, p.entityAggregation)
+		if err != nil {
+			fetchErrs[i] = fmt.Errorf("error building emission data points for serial number %s: %w", serialNum, err)
+			return
+		}
+		fetched[i] = &entityData
+	}, "GreenScheduling")
+	if err := ctx.Err(); err != nil {
+		// Pieces are skipped once the context is done.
+		return nil, err
+	}
+
+	for i, serialNum := range knownSerialNums {
+		if fetched[i] != nil {
+			data[serialNum] = *fetched[i]
+		} else if fetchErrs[i] != nil {
+			errs = append(errs, fetchErrs[i])
+		}
+	}
+	if len(errs) > 0 {
+		if len(data) == 0 {
+			return nil, errors.Join(errs...)
+		}
+		klog.ErrorS(errors.Join(errs...), "Leaving out the SIC data of some serial numbers", "fetched", len(data), "errors", len(errs))
+	}
+	return data, nil
+}
+
+// GetLocationCarbonData fetches SIC data aggregated per location, in parallel. SIC reports the
+// usage of every entity at a location and the usage series of all of them together, so both are
+// averaged over the entities, making a location comparable with a single serial number.
+func (p *SICProvider) GetLocationCarbonData(ctx context.Context, locations []Location, startTime, endTime time.Time) (map[Location]CarbonData, error) {
+	data := make(map[Location]CarbonData, len(locations))
+	if len(locations) == 0 {
+		return data, nil
+	}
+
+	start, end := startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)
+
+	fetched := make([]*CarbonData, len(locations))
+	errCh := parallelize.NewErrorChannel()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p.parallelizer.Until(ctx, len(locations), func(i int) {
+		location := locations[i]
+		filter, err := sicparams.NewFilter(location.FilterKey, sicparams.FilterOperatorEquals, location.Value)
+		if err != nil {
+			errCh.SendErrorWithCancel(fmt.Errorf("error creating filter for location %s: %w", location.Key(), err), cancel)
+			return
+		}
+		params := sicparams.New().AddFilter(filter)
+
+		usageByEntity, err := p.client.GetAllUsageByEntity(ctx, start, end, params)
+		if err != nil {
+			errCh.SendErrorWithCancel(fmt.Errorf("error fetching usage by entity data for location %s: %w", location.Key(), err), cancel)
+			return
+		}
+		if len(usageByEntity.Items) == 0 {
+			klog.Warningf("No SIC usage data found for location %s", location.Key())
+			return
+		}
+
+		usageSeries, err := p.client.GetUsageSeries(ctx, start, end, p.seriesInterval, params)
+		if err != nil {
+			errCh.SendErrorWithCancel(fmt.Errorf("error fetching usage series data for location %s: %w", location.Key(), err), cancel)
+			return
+		}
+		locationData, err := NewCarbonDataFromSIC(usageByEntity.Items, usageSeries, config.AverageEntityAggregation)
+		if err != nil {
+			errCh.SendErrorWithCancel(fmt.Errorf("error building emission data points for location %s: %w", location.Key(), err), cancel)
+			return
+		}
+		// A typical node at the location has no particular hardware.
+		locationData.Hardware = sustainabilityprofile.Hardware{}
+		fetched[i] = &locationData
+	}, "GreenScheduling")
+	if err := errCh.ReceiveError(); err != nil {
+		return nil, err
+	}
+
+	for i, location := range locations {
+		if fetched[i] != nil {
+			data[location] = *fetched[i]
+		}
+	}
+	return data, nil
+}
+
+// NewCarbonDataFromSIC converts the SIC usage of the entities of a node, and their usage series
+// aggregated by SIC, to carbon data. The usage of the entities is summed, or averaged over them
+// weighted by their energy consumption with AverageEntityAggregation, in which case the usage
+// series is scaled like the totals. The hardware is that of the first entity describing its make.
+func NewCarbonDataFromSIC(entities []sicresponse.UsageEntity, usageSeries *sicresponse.UsageSeriesResponse, aggregation config.EntityAggregationType) (CarbonData, error) {
+	dataPoints, err := buildEmissionDataPoints(usageSeries)
+	if err != nil {
+		return CarbonData{}, err
+	}
+	data := CarbonData{Emissions: dataPoints}
+	for i := range entities {
+		entity := &entities[i]
+		data.TotalCo2 += entity.GetCo2eMetricTon()
+		data.TotalCost += entity.GetCostUsd()
+		data.TotalKwh += entity.GetKwh()
+		if data.Hardware.Make == "" {
+			data.Hardware = buildHardware(entity)
+		}
+	}
+
+	if aggregation == config.AverageEntityAggregation && len(entities) > 1 {
+		averageEntities(&data, entities)
+	}
+	return data, nil
+}
+
+// averageEntities replaces the summed usage of the entities by its average, weighted by the
+// energy consumption of every entity, or the plain mean if none reports any. The usage series,
+// summed by SIC across the entities, is scaled by the ratio of the average to the sum.
+func averageEntities(data *CarbonData, entities []sicresponse.UsageEntity) {
+	var totalKwh float64
+	for i := range entities {
+		totalKwh += entities[i].GetKwh()
+	}
+	n := float64(len(entities))
+
+	var co2, cost, kwh float64
+	for i := range entities {
+		entity := &entities[i]
+		weight := 1 / n
+		if totalKwh > 0 {
+			weight = entity.GetKwh() / totalKwh
+		}
+		co2 += weight * entity.GetCo2eMetricTon()
+		cost += weight * entity.GetCostUsd()
+		kwh += weight * entity.GetKwh()
+	}
+
+	co2Ratio, kwhRatio := 1/n, 1/n
+	if data.TotalCo2 > 0 {
+		co2Ratio = co2 / data.TotalCo2
+	}
+	if data.TotalKwh > 0 {
+		kwhRatio = kwh / data.TotalKwh
+	}
+	for i := range data.Emissions {
+		data.Emissions[i].Co2 *= co2Ratio
+		data.Emissions[i].Kwh *= kwhRatio
+	}
+	data.TotalCo2, data.TotalCost, data.TotalKwh = co2, cost, kwh
+}
+
+// buildHardware builds the hardware description of a usage entity. An invalid manufacture
+// timestamp is logged and left unset.
+func buildHardware(entity *sicresponse.UsageEntity) sustainabilityprofile.Hardware {
+	hardware := sustainabilityprofile.Hardware{
+		Make:  entity.EntityMake,
+		Model: entity.EntityModel,
+	}
+	if entity.EntityManufactureTimestamp != "" {
+		manufacturedAt, err := time.Parse(time.RFC3339, entity.EntityManufactureTimestamp)
+		if err != nil {
+			klog.V(4).InfoS("Ignoring invalid manufacture timestamp", "serialNum", entity.EntitySerialNum, "timestamp", entity.EntityManufactureTimestamp, "err", err)
+		} else {
+			hardware.ManufacturedAt = manufacturedAt
+		}
+	}
+	return hardware
+}
+
+// buildSicParams constructs SIC parameters based on the serial number.
+func buildSicParams(serialNum string) (*sicparams.Params, error) {
+	filter, err := sicparams.NewFilter(sicparams.FilterKeyEntitySerialNum, sicparams.FilterOperatorEquals, serialNum)
+	if err != nil {
+		return sicparams.New(), fmt.Errorf("error creating filter: %w", err)
+	}
+
+	return sicparams.New().AddFilter(filter), nil
+}
+
+// buildEmissionDataPoints processes usage series data into emission data points.
+func buildEmissionDataPoints(usageSeries *sicresponse.UsageSeriesResponse) ([]sustainabilityprofile.EmissionDataPoint, error) {
+	var dataPoints []sustainabilityprofile.EmissionDataPoint
+	for _, entity := range usageSeries.Items {
+		timeBucket, err := entity.GetTimeBucket()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving time bucket: %w", err)
+		}
+		dataPoints = append(dataPoints, sustainabilityprofile.EmissionDataPoint{
+			Co2:  entity.GetCo2eMetricTon(),
+			Kwh:  entity.GetKwh(),
+			Time: timeBucket,
+		})
+	}
+	return dataPoints, nil
+}
@@ -0,0 +1,108 @@
+package carbonprovider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// StaticFile is the format of the file read by the StaticFileProvider, keyed by the
+// value of the nodes' serial number label. For example:
+//
+//	nodes:
+//	  SN123:
+//	    totalCO2: 1.2
+//	    totalCost: 340
+//	    totalKwh: 5100
+//	    make: HPE
+//	    model: ProLiant DL380 Gen10
+//	    manufacturedAt: "2021-06-01T00:00:00Z"
+//	    emissions:
+//	    - time: "2024-01-01T00:00:00Z"
+//	      co2: 0.04
+//	      kwh: 170
+type StaticFile struct {
+	Nodes map[string]StaticNodeData `json:"nodes"`
+}
+
+// StaticNodeData holds the carbon data of a single node in a StaticFile.
+type StaticNodeData struct {
+	TotalCO2  float64          `json:"totalCO2"`
+	TotalCost float64          `json:"totalCost"`
+	TotalKwh  float64          `json:"totalKwh"`
+	Emissions []StaticEmission `json:"emissions"`
+
+	// Hardware of the node, optional.
+	Make           string    `json:"make,omitempty"`
+	Model          string    `json:"model,omitempty"`
+	ManufacturedAt time.Time `json:"manufacturedAt,omitempty"`
+}
+
+// StaticEmission holds a single emission data point in a StaticFile.
+type StaticEmission struct {
+	Time time.Time `json:"time"`
+	CO2  float64   `json:"co2"`
+	Kwh  float64   `json:"kwh"`
+}
+
+// StaticFileProvider provides carbon data from a JSON or YAML file, typically a mounted ConfigMap.
+// The file is read on every call, so that updates to the ConfigMap are picked up without a restart.
+type StaticFileProvider struct {
+	path string
+}
+
+var _ CarbonDataProvider = &StaticFileProvider{}
+
+// NewStaticFileProvider creates a StaticFileProvider reading the file in the given spec.
+func NewStaticFileProvider(spec *config.StaticFileProviderSpec) *StaticFileProvider {
+	return &StaticFileProvider{path: spec.Path}
+}
+
+// GetCarbonData reads the carbon data of the given keys from the file, keeping only the
+// emission data points between startTime and endTime.
+func (p *StaticFileProvider) GetCarbonData(_ context.Context, keys []string, startTime, endTime time.Time) (map[string]CarbonData, error) {
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading carbon data file %s: %w", p.path, err)
+	}
+
+	var file StaticFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("error parsing carbon data file %s: %w", p.path, err)
+	}
+
+	data := make(map[string]CarbonData, len(keys))
+	for _, key := range keys {
+		node, ok := file.Nodes[key]
+		if !ok {
+			continue
+		}
+
+		var dataPoints []sustainabilityprofile.EmissionDataPoint
+		for _, emission := range node.Emissions {
+			if emission.Time.Before(startTime) || emission.Time.After(endTime) {
+				continue
+			}
+			dataPoints = append(dataPoints, sustainabilityprofile.NewEmissionDataPoint(emission.CO2, emission.Kwh, emission.Time))
+		}
+
+		data[key] = CarbonData{
+			Emissions: dataPoints,
+			TotalCo2:  node.TotalCO2,
+			TotalCost: node.TotalCost,
+			TotalKwh:  node.TotalKwh,
+			Hardware: sustainabilityprofile.Hardware{
+				Make:           node.Make,
+				Model:          node.Model,
+				ManufacturedAt: node.ManufacturedAt,
+			},
+		}
+	}
+	return data, nil
+}
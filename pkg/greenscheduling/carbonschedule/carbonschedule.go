@@ -0,0 +1,290 @@
+// Package carbonschedule evaluates the recurring time windows of CarbonSchedule objects, which
+// scale the carbon intensity of the nodes of a location at known times, e.g. during solar and
+// wind peaks or contracted green power periods that historical carbon data does not capture.
+package carbonschedule
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/generated/clientset/versioned"
+	schedinformers "sigs.k8s.io/scheduler-plugins/pkg/generated/informers/externalversions"
+)
+
+// syncTimeout bounds the wait for the CarbonSchedule informer to sync, which never happens if the
+// CRD is not installed or the scheduler is not allowed to list and watch CarbonSchedules.
+var syncTimeout = time.Minute
+
+// Schedules evaluates the CarbonSchedule objects of the cluster. Objects are parsed once, when
+// they are added or updated, so that evaluating them only matches node labels. Invalid schedules,
+// e.g. with an unknown time zone or a non-positive multiplier, are skipped.
+type Schedules struct {
+	mu        sync.RWMutex
+	schedules map[string]*schedule
+}
+
+var _ cache.ResourceEventHandler = &Schedules{}
+
+// New creates Schedules without any schedule. They are kept up to date as an event handler of a
+// CarbonSchedule informer.
+func New() *Schedules {
+	return &Schedules{schedules: make(map[string]*schedule)}
+}
+
+// NewFromConfig creates Schedules kept up to date by a dedicated CarbonSchedule informer,
+// running until the context is done.
+func NewFromConfig(ctx context.Context, kubeConfig *rest.Config) (*Schedules, error) {
+	client, err := versioned.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating scheduling client: %w", err)
+	}
+	informerFactory := schedinformers.NewSharedInformerFactory(client, 0)
+	informer := informerFactory.Scheduling().V1alpha1().CarbonSchedules().Informer()
+	s := New()
+	if _, err := informer.AddEventHandler(s); err != nil {
+		return nil, fmt.Errorf("error watching CarbonSchedules: %w", err)
+	}
+
+	informerFactory.Start(ctx.Done())
+	syncCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		return nil, fmt.Errorf("timed out after %v waiting for the CarbonSchedule informer to sync, check that the CarbonSchedule CRD is installed and the scheduler is allowed to list and watch CarbonSchedules", syncTimeout)
+	}
+	return s, nil
+}
+
+// OnAdd parses an added CarbonSchedule.
+func (s *Schedules) OnAdd(obj interface{}, _ bool) {
+	if object, ok := obj.(*schedv1alpha1.CarbonSchedule); ok {
+		s.store(object)
+	}
+}
+
+// OnUpdate parses an updated CarbonSchedule, replacing its previous version.
+func (s *Schedules) OnUpdate(_, newObj interface{}) {
+	if object, ok := newObj.(*schedv1alpha1.CarbonSchedule); ok {
+		s.store(object)
+	}
+}
+
+// OnDelete drops a deleted CarbonSchedule.
+func (s *Schedules) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if object, ok := obj.(*schedv1alpha1.CarbonSchedule); ok {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.schedules, object.Name)
+	}
+}
+
+// store parses a CarbonSchedule and stores it by name. Invalid schedules are dropped.
+func (s *Schedules) store(object *schedv1alpha1.CarbonSchedule) {
+	sched, err := parse(object)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		klog.V(4).InfoS("Skipping invalid CarbonSchedule", "carbonSchedule", klog.KObj(object), "err", err)
+		delete(s.schedules, object.Name)
+		return
+	}
+	s.schedules[object.Name] = sched
+}
+
+// list returns the parsed schedules.
+func (s *Schedules) list() []*schedule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	schedules := make([]*schedule, 0, len(s.schedules))
+	for _, sched := range s.schedules {
+		schedules = append(schedules, sched)
+	}
+	return schedules
+}
+
+// Multipliers returns the carbon intensity multipliers of the nodes at the given time, keyed by
+// node name. Nodes without any active window are omitted.
+func (s *Schedules) Multipliers(nodes []*v1.Node, now time.Time) map[string]float64 {
+	schedules := s.list()
+	multipliers := map[string]float64{}
+	for _, node := range nodes {
+		if multiplier := multiplier(schedules, node.Labels, now); multiplier != 1 {
+			multipliers[node.Name] = multiplier
+		}
+	}
+	return multipliers
+}
+
+// Multiplier returns the product of the carbon intensity multipliers of the windows active at
+// the given time of all schedules selecting a node with the given labels, 1 if none is active.
+func (s *Schedules) Multiplier(nodeLabels map[string]string, now time.Time) float64 {
+	return multiplier(s.list(), nodeLabels, now)
+}
+
+// NextGreenWindow returns the start of the next window with a multiplier below 1 of the
+// schedules selecting a node with the given labels, looking up to a week ahead of the given time.
+func (s *Schedules) NextGreenWindow(nodeLabels map[string]string, now time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, sched := range s.list() {
+		if !sched.selector.Matches(labels.Set(nodeLabels)) {
+			continue
+		}
+		for _, w := range sched.windows {
+			if w.multiplier >= 1 {
+				continue
+			}
+			if start, ok := w.nextStart(now.In(sched.location)); ok && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// multiplier returns the product of the multipliers of the active windows of the schedules
+// selecting a node with the given labels.
+func multiplier(schedules []*schedule, nodeLabels map[string]string, now time.Time) float64 {
+	product := 1.0
+	for _, sched := range schedules {
+		if !sched.selector.Matches(labels.Set(nodeLabels)) {
+			continue
+		}
+		for _, w := range sched.windows {
+			if w.activeAt(now.In(sched.location)) {
+				product *= w.multiplier
+			}
+		}
+	}
+	return product
+}
+
+// schedule is a parsed CarbonSchedule.
+type schedule struct {
+	selector labels.Selector
+	location *time.Location
+	windows  []window
+}
+
+// window is a parsed CarbonWindow, its start and end in minutes since midnight.
+type window struct {
+	days       map[time.Weekday]bool
+	start, end int
+	multiplier float64
+}
+
+var weekdays = map[schedv1alpha1.Weekday]time.Weekday{
+	"Sunday":    time.Sunday,
+	"Monday":    time.Monday,
+	"Tuesday":   time.Tuesday,
+	"Wednesday": time.Wednesday,
+	"Thursday":  time.Thursday,
+	"Friday":    time.Friday,
+	"Saturday":  time.Saturday,
+}
+
+// parse parses a CarbonSchedule. An empty node selector selects all nodes.
+func parse(object *schedv1alpha1.CarbonSchedule) (*schedule, error) {
+	sched := &schedule{selector: labels.Everything(), location: time.UTC}
+	if object.Spec.NodeSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(object.Spec.NodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node selector: %w", err)
+		}
+		sched.selector = selector
+	}
+	if object.Spec.TimeZone != "" {
+		location, err := time.LoadLocation(object.Spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone: %w", err)
+		}
+		sched.location = location
+	}
+
+	for i, spec := range object.Spec.Windows {
+		w := window{multiplier: spec.CarbonIntensityMultiplier.AsApproximateFloat64()}
+		if w.multiplier <= 0 {
+			return nil, fmt.Errorf("window %d: carbon intensity multiplier must be greater than 0", i)
+		}
+		var err error
+		if w.start, err = parseTimeOfDay(spec.Start); err != nil {
+			return nil, fmt.Errorf("window %d: invalid start: %w", i, err)
+		}
+		if w.end, err = parseTimeOfDay(spec.End); err != nil {
+			return nil, fmt.Errorf("window %d: invalid end: %w", i, err)
+		}
+		if len(spec.Days) > 0 {
+			w.days = make(map[time.Weekday]bool, len(spec.Days))
+			for _, day := range spec.Days {
+				weekday, ok := weekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("window %d: invalid day %q", i, day)
+				}
+				w.days[weekday] = true
+			}
+		}
+		sched.windows = append(sched.windows, w)
+	}
+	return sched, nil
+}
+
+// parseTimeOfDay parses a HH:MM time of day to minutes since midnight.
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// on returns whether the window starts on the given day of the week.
+func (w window) on(day time.Weekday) bool {
+	return w.days == nil || w.days[day]
+}
+
+// activeAt returns whether the window is active at the given time, expressed in the time zone of
+// its schedule. Windows ending at or before their start end on the next day, so the window may
+// also have started the day before.
+func (w window) activeAt(now time.Time) bool {
+	year, month, day := now.Date()
+	for _, offset := range []int{0, -1} {
+		start := time.Date(year, month, day+offset, 0, w.start, 0, 0, now.Location())
+		if !w.on(start.Weekday()) {
+			continue
+		}
+		endDay := day + offset
+		if w.end <= w.start {
+			endDay++
+		}
+		end := time.Date(year, month, endDay, 0, w.end, 0, 0, now.Location())
+		if !now.Before(start) && now.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextStart returns the next start of the window after the given time, expressed in the time
+// zone of its schedule.
+func (w window) nextStart(now time.Time) (time.Time, bool) {
+	year, month, day := now.Date()
+	for offset := 0; offset <= 7; offset++ {
+		start := time.Date(year, month, day+offset, 0, w.start, 0, 0, now.Location())
+		if start.After(now) && w.on(start.Weekday()) {
+			return start, true
+		}
+	}
+	return time.Time{}, false
+}
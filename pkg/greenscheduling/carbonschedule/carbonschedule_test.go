@@ -0,0 +1,188 @@
+package carbonschedule
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+// newTestSchedules creates Schedules evaluating the given CarbonSchedules.
+func newTestSchedules(schedules ...*schedv1alpha1.CarbonSchedule) *Schedules {
+	s := New()
+	for _, schedule := range schedules {
+		s.OnAdd(schedule, true)
+	}
+	return s
+}
+
+func newCarbonSchedule(name string, selector map[string]string, timeZone string, windows ...schedv1alpha1.CarbonWindow) *schedv1alpha1.CarbonSchedule {
+	schedule := &schedv1alpha1.CarbonSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       schedv1alpha1.CarbonScheduleSpec{TimeZone: timeZone, Windows: windows},
+	}
+	if selector != nil {
+		schedule.Spec.NodeSelector = &metav1.LabelSelector{MatchLabels: selector}
+	}
+	return schedule
+}
+
+func newWindow(start, end, multiplier string, days ...schedv1alpha1.Weekday) schedv1alpha1.CarbonWindow {
+	return schedv1alpha1.CarbonWindow{Days: days, Start: start, End: end, CarbonIntensityMultiplier: resource.MustParse(multiplier)}
+}
+
+func TestMultiplier(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+	frankfurt := map[string]string{v1.LabelTopologyZone: "eu-central-1a"}
+	schedules := newTestSchedules(
+		// Solar peak in Frankfurt on weekdays, in local time.
+		newCarbonSchedule("solar", frankfurt, "Europe/Berlin",
+			newWindow("11:00", "15:00", "0.5", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday")),
+		// Night wind across all locations, wrapping past midnight.
+		newCarbonSchedule("wind", nil, "",
+			newWindow("20:00", "04:00", "0.25")),
+		// Evening peak in Frankfurt, every day.
+		newCarbonSchedule("peak", frankfurt, "Europe/Berlin",
+			newWindow("18:00", "23:00", "1.5")),
+		// Invalid schedules are skipped.
+		newCarbonSchedule("invalid-zone", nil, "Mars/Olympus", newWindow("00:00", "00:00", "0.1")),
+		newCarbonSchedule("invalid-multiplier", nil, "", newWindow("00:00", "00:00", "0")),
+		newCarbonSchedule("invalid-time", nil, "", newWindow("25:00", "03:00", "0.1")),
+	)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		now    time.Time
+		want   float64
+	}{
+		{
+			name:   "solar window in local time",
+			labels: frankfurt,
+			now:    time.Date(2024, time.June, 12, 12, 30, 0, 0, berlin),
+			want:   0.5,
+		},
+		{
+			name:   "solar window ends exclusively",
+			labels: frankfurt,
+			now:    time.Date(2024, time.June, 12, 15, 0, 0, 0, berlin),
+			want:   1,
+		},
+		{
+			name:   "solar window evaluated in its time zone",
+			labels: frankfurt,
+			now:    time.Date(2024, time.June, 12, 9, 30, 0, 0, time.UTC),
+			want:   0.5,
+		},
+		{
+			name:   "solar window not on weekends",
+			labels: frankfurt,
+			now:    time.Date(2024, time.June, 15, 12, 30, 0, 0, berlin),
+			want:   1,
+		},
+		{
+			name:   "solar window not selecting other nodes",
+			labels: map[string]string{v1.LabelTopologyZone: "us-east-1a"},
+			now:    time.Date(2024, time.June, 12, 12, 30, 0, 0, berlin),
+			want:   1,
+		},
+		{
+			name:   "wind window before midnight",
+			labels: nil,
+			now:    time.Date(2024, time.June, 12, 23, 0, 0, 0, time.UTC),
+			want:   0.25,
+		},
+		{
+			name:   "wind window after midnight",
+			labels: nil,
+			now:    time.Date(2024, time.June, 13, 3, 59, 0, 0, time.UTC),
+			want:   0.25,
+		},
+		{
+			name:   "overlapping windows multiply",
+			labels: frankfurt,
+			now:    time.Date(2024, time.June, 12, 20, 30, 0, 0, time.UTC),
+			want:   0.25 * 1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedules.Multiplier(tt.labels, tt.now); got != tt.want {
+				t.Errorf("expected multiplier %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSchedulesEventHandlers(t *testing.T) {
+	green := newCarbonSchedule("solar", nil, "", newWindow("00:00", "00:00", "0.5"))
+	s := newTestSchedules(green)
+	now := time.Date(2024, time.June, 12, 12, 0, 0, 0, time.UTC)
+	if got := s.Multiplier(nil, now); got != 0.5 {
+		t.Fatalf("expected the added schedule to apply, got multiplier %v", got)
+	}
+
+	updated := newCarbonSchedule("solar", nil, "", newWindow("00:00", "00:00", "0.25"))
+	s.OnUpdate(green, updated)
+	if got := s.Multiplier(nil, now); got != 0.25 {
+		t.Errorf("expected the updated schedule to replace the previous one, got multiplier %v", got)
+	}
+
+	// Schedules updated to an invalid spec stop applying.
+	invalid := newCarbonSchedule("solar", nil, "Mars/Olympus", newWindow("00:00", "00:00", "0.25"))
+	s.OnUpdate(updated, invalid)
+	if got := s.Multiplier(nil, now); got != 1 {
+		t.Errorf("expected the invalid schedule to be dropped, got multiplier %v", got)
+	}
+
+	s.OnUpdate(invalid, updated)
+	s.OnDelete(cache.DeletedFinalStateUnknown{Key: "solar", Obj: updated})
+	if got := s.Multiplier(nil, now); got != 1 {
+		t.Errorf("expected the deleted schedule to be dropped, got multiplier %v", got)
+	}
+}
+
+func TestNextGreenWindow(t *testing.T) {
+	schedules := newTestSchedules(
+		newCarbonSchedule("solar", nil, "", newWindow("11:00", "15:00", "0.5", "Monday")),
+		newCarbonSchedule("peak", nil, "", newWindow("09:00", "10:00", "1.5")),
+	)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		now    time.Time
+		want   time.Time
+	}{
+		{
+			name: "later the same day",
+			now:  time.Date(2024, time.June, 10, 8, 0, 0, 0, time.UTC),
+			want: time.Date(2024, time.June, 10, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "next week while active",
+			now:  time.Date(2024, time.June, 10, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2024, time.June, 17, 11, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := schedules.NextGreenWindow(tt.labels, tt.now)
+			if !ok || !got.Equal(tt.want) {
+				t.Errorf("expected next green window at %v, got %v (found %v)", tt.want, got, ok)
+			}
+		})
+	}
+
+	if _, ok := newTestSchedules(newCarbonSchedule("peak", nil, "", newWindow("09:00", "10:00", "1.5"))).NextGreenWindow(nil, time.Now()); ok {
+		t.Errorf("expected no green window without a multiplier below 1")
+	}
+}
@@ -1,5 +1,12 @@
 package greenscheduling
 
+import (
+	"time"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+)
+
 // TimeSeriesConfig holds time-related configurations for time series data collection.
 type TimeSeriesConfig struct {
 	DaysToConsider float64
@@ -8,15 +15,66 @@ type TimeSeriesConfig struct {
 
 // SustainabilityWeights holds weighting factors for sustainability metrics.
 type SustainabilityWeights struct {
-	CO2DecayWeight float64
-	TotalCO2Weight float64
-	CostWeight     float64
-	DecayRate      float64
+	CO2DecayWeight    float64
+	TotalCO2Weight    float64
+	CostWeight        float64
+	DecayRate         float64
+	EnergyDecayWeight float64
+	EnergyWeight      float64
+}
+
+// DecayConfig holds the configuration of the model weighing emission data points by their age.
+type DecayConfig struct {
+	Model    config.DecayModelType
+	Window   time.Duration
+	HalfLife time.Duration
+}
+
+// EmbodiedCarbonConfig holds the configuration of the scoring of the embodied carbon of hardware.
+type EmbodiedCarbonConfig struct {
+	Weight      float64
+	Lifetime    time.Duration
+	PreferNewer bool
+	Footprints  map[string]float64
+}
+
+// CacheConfig holds the configuration of the sustainability score cache.
+type CacheConfig struct {
+	TTL           time.Duration
+	RefreshPeriod time.Duration
+}
+
+// DeferralConfig holds the configuration of the deferral of delay-tolerant pods.
+type DeferralConfig struct {
+	CO2Threshold   float64
+	CheckPeriod    time.Duration
+	DeadlineMargin time.Duration
+}
+
+// CeilingConfig holds the maximum CO2 emissions and energy consumption of the nodes pods may be
+// scheduled on, 0 disabling a ceiling.
+type CeilingConfig struct {
+	MaxCO2 float64
+	MaxKwh float64
+}
+
+// ConsolidationConfig holds the share of the score given to bin-packing, 0 disabling consolidation.
+type ConsolidationConfig struct {
+	PackingWeight float64
 }
 
 // Config holds the configuration values for the Green Scheduling plugin.
 type Config struct {
 	TimeSeriesConfig      TimeSeriesConfig
 	SustainabilityWeights SustainabilityWeights
+	DecayConfig           DecayConfig
+	EmbodiedCarbonConfig  EmbodiedCarbonConfig
+	ScoreNormalization    config.ScoreNormalizationType
 	SerialNumLabel        string
+	CacheConfig           CacheConfig
+	DeferralConfig        DeferralConfig
+	CeilingConfig         CeilingConfig
+	LocationFallback      carbonprovider.LocationFallback
+	ConsolidationConfig   ConsolidationConfig
+	ScoreBreakdown        config.ScoreBreakdownType
 }
@@ -0,0 +1,64 @@
+package greenscheduling
+
+import (
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	resourcehelper "k8s.io/kubernetes/pkg/api/v1/resource"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	schedutil "k8s.io/kubernetes/pkg/scheduler/util"
+)
+
+// requestedShares returns, keyed by node name, the share of the allocatable CPU and memory of
+// every node that is requested once the pod is placed on it. Like the scheduler's resource
+// scoring, unset requests count as the scheduler's defaults, so that pods without requests still
+// add packing pressure.
+func requestedShares(pod *v1.Pod, nodes []*framework.NodeInfo) map[string]float64 {
+	podRequests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{
+		NonMissingContainerRequests: v1.ResourceList{
+			v1.ResourceCPU:    *resource.NewMilliQuantity(schedutil.DefaultMilliCPURequest, resource.DecimalSI),
+			v1.ResourceMemory: *resource.NewQuantity(schedutil.DefaultMemoryRequest, resource.DecimalSI),
+		},
+	})
+	podRequest := framework.NewResource(podRequests)
+
+	shares := make(map[string]float64, len(nodes))
+	for _, nodeInfo := range nodes {
+		if node := nodeInfo.Node(); node != nil {
+			shares[node.Name] = requestedShare(nodeInfo, podRequest)
+		}
+	}
+	return shares
+}
+
+// requestedShare returns the share of the allocatable CPU and memory of the node requested once
+// the pod is placed on it, averaged over both resources and capped at 1. Resources the node does
+// not offer are ignored.
+func requestedShare(nodeInfo *framework.NodeInfo, podRequest *framework.Resource) float64 {
+	var sum float64
+	var resources int
+	if allocatable := nodeInfo.Allocatable.MilliCPU; allocatable > 0 {
+		sum += math.Min(float64(nodeInfo.NonZeroRequested.MilliCPU+podRequest.MilliCPU)/float64(allocatable), 1)
+		resources++
+	}
+	if allocatable := nodeInfo.Allocatable.Memory; allocatable > 0 {
+		sum += math.Min(float64(nodeInfo.NonZeroRequested.Memory+podRequest.Memory)/float64(allocatable), 1)
+		resources++
+	}
+	if resources == 0 {
+		return 0
+	}
+	return sum / float64(resources)
+}
+
+// consolidate blends the normalized sustainability scores with the requested shares of the nodes,
+// the packing weight setting the share of bin-packing in the result. Busy, green nodes score
+// highest, so that pods pack onto them, while lightly used, carbon-intensive nodes score lowest
+// and drain over time, so that an autoscaler can remove them.
+func consolidate(scores framework.NodeScoreList, shares map[string]float64, packingWeight float64) {
+	for i, node := range scores {
+		packing := shares[node.Name] * float64(framework.MaxNodeScore)
+		scores[i].Score = int64(math.Round((1-packingWeight)*float64(node.Score) + packingWeight*packing))
+	}
+}
@@ -0,0 +1,155 @@
+package greenscheduling
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+const (
+	// AnnotationKeyPrefix is the prefix of the pod annotations read by the GreenScheduling plugin.
+	AnnotationKeyPrefix = "green-scheduling.scheduling.x-k8s.io/"
+	// AnnotationKeyDelayTolerant marks a pod, with the value "true", as tolerating a delayed start
+	// while the carbon emissions of its node are high.
+	AnnotationKeyDelayTolerant = AnnotationKeyPrefix + "delay-tolerant"
+	// AnnotationKeyDeadline holds the RFC 3339 time by which a delay-tolerant pod must be bound.
+	AnnotationKeyDeadline = AnnotationKeyPrefix + "deadline"
+)
+
+var _ framework.PermitPlugin = &GreenScheduling{}
+
+// Permit holds delay-tolerant pods in the Wait state while the decayed CO2 trend of the chosen node
+// is above the configured threshold. Pods are released by a background loop once the trend drops,
+// a green CarbonSchedule window starts on the node, or their deadline approaches. Pods still
+// waiting when the framework's maximum Permit timeout elapses are rejected and evaluated again on
+// their next scheduling attempt.
+func (gks *GreenScheduling) Permit(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) (*framework.Status, time.Duration) {
+	if gks.config.DeferralConfig.CO2Threshold <= 0 {
+		return framework.NewStatus(framework.Success), 0
+	}
+	deadline, ok := deferralDeadline(pod)
+	if !ok {
+		return framework.NewStatus(framework.Success), 0
+	}
+	now := time.Now()
+	waitTime := deadline.Add(-gks.config.DeferralConfig.DeadlineMargin).Sub(now)
+	if waitTime <= 0 || !gks.shouldDefer(nodeName, now) {
+		return framework.NewStatus(framework.Success), 0
+	}
+
+	// Pods are released early, by the background loop, if a green window of the node starts first.
+	keysAndValues := []interface{}{"pod", klog.KObj(pod), "node", nodeName, "deadline", deadline}
+	if start, ok := gks.nextGreenWindow(nodeName, now); ok {
+		keysAndValues = append(keysAndValues, "nextGreenWindow", start)
+	}
+	klog.V(3).InfoS("Deferring delay-tolerant pod while carbon emissions are high", keysAndValues...)
+	return framework.NewStatus(framework.Wait), waitTime
+}
+
+// runDeferral re-evaluates deferred pods every check period until the context is done.
+func (gks *GreenScheduling) runDeferral(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		gks.releaseDeferredPods(time.Now())
+	}, gks.config.DeferralConfig.CheckPeriod)
+}
+
+// releaseDeferredPods allows the deferred pods whose deadline is within the deadline margin, or
+// whose node's CO2 trend has dropped to the threshold or is within a green window.
+func (gks *GreenScheduling) releaseDeferredPods(now time.Time) {
+	gks.handle.IterateOverWaitingPods(func(waitingPod framework.WaitingPod) {
+		if !isPendingOn(waitingPod, Name) {
+			return
+		}
+		// Waiting pods are assumed on their node, so their node name is set.
+		pod := waitingPod.GetPod()
+		deadline, ok := deferralDeadline(pod)
+		if ok && now.Before(deadline.Add(-gks.config.DeferralConfig.DeadlineMargin)) && gks.shouldDefer(pod.Spec.NodeName, now) {
+			return
+		}
+		klog.V(3).InfoS("Releasing deferred pod", "pod", klog.KObj(pod), "node", pod.Spec.NodeName)
+		waitingPod.Allow(Name)
+	})
+}
+
+// shouldDefer returns whether the decayed CO2 trend of the node is above the deferral threshold.
+// Nodes without carbon data, or within a green window, are never deferred.
+func (gks *GreenScheduling) shouldDefer(nodeName string, now time.Time) bool {
+	if gks.inGreenWindow(nodeName, now) {
+		return false
+	}
+	key, err := gks.getNodeKey(nodeName)
+	if err != nil {
+		return false
+	}
+	// Keep the data of the nodes of deferred pods tracked for as long as they wait.
+	gks.scoreCache.Track(key)
+	entry, ok := gks.scoreCache.Get(key)
+	if !ok && !gks.scoreCache.Healthy() {
+		entry, ok = gks.scoreCache.GetLastKnown(key)
+	}
+	if !ok {
+		return false
+	}
+	trend := entry.Profile.DecayedCO2(gks.decayModel(gks.config.SustainabilityWeights.DecayRate))
+	klog.V(5).InfoS("Carbon trend of node", "node", nodeName, "co2", trend, "threshold", gks.config.DeferralConfig.CO2Threshold)
+	return trend > gks.config.DeferralConfig.CO2Threshold
+}
+
+// inGreenWindow returns whether the CarbonSchedule windows active on the node lower its carbon
+// intensity.
+func (gks *GreenScheduling) inGreenWindow(nodeName string, now time.Time) bool {
+	if gks.carbonSchedules == nil {
+		return false
+	}
+	labels, err := gks.kubeClient.GetNodeLabels(nodeName)
+	if err != nil {
+		return false
+	}
+	return gks.carbonSchedules.Multiplier(labels, now) < 1
+}
+
+// nextGreenWindow returns the start of the next CarbonSchedule window lowering the carbon
+// intensity of the node.
+func (gks *GreenScheduling) nextGreenWindow(nodeName string, now time.Time) (time.Time, bool) {
+	if gks.carbonSchedules == nil {
+		return time.Time{}, false
+	}
+	labels, err := gks.kubeClient.GetNodeLabels(nodeName)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return gks.carbonSchedules.NextGreenWindow(labels, now)
+}
+
+// deferralDeadline returns the deadline of a delay-tolerant pod. Pods that are not annotated as
+// delay-tolerant, or lack a valid deadline, are not deferred.
+func deferralDeadline(pod *v1.Pod) (time.Time, bool) {
+	if tolerant, err := strconv.ParseBool(pod.Annotations[AnnotationKeyDelayTolerant]); err != nil || !tolerant {
+		return time.Time{}, false
+	}
+	value, ok := pod.Annotations[AnnotationKeyDeadline]
+	if !ok {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.V(4).InfoS("Ignoring invalid deferral deadline", "pod", klog.KObj(pod), "deadline", value, "err", err)
+		return time.Time{}, false
+	}
+	return deadline, true
+}
+
+// isPendingOn returns whether the waiting pod waits on the given plugin.
+func isPendingOn(waitingPod framework.WaitingPod, pluginName string) bool {
+	for _, plugin := range waitingPod.GetPendingPlugins() {
+		if plugin == pluginName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package greenscheduling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+const (
+	// AnnotationKeyScoreBreakdown holds, as JSON, the breakdown of the sustainability scores of
+	// the node a pod was bound to and of the runner-up, set by the plugin after binding if the
+	// scoreBreakdown arg is Always, or OptIn and the pod requests it.
+	AnnotationKeyScoreBreakdown = AnnotationKeyPrefix + "score-breakdown"
+	// AnnotationKeyExplainScore requests, with the value "true", the score breakdown annotation
+	// of a pod when the scoreBreakdown arg is OptIn.
+	AnnotationKeyExplainScore = AnnotationKeyPrefix + "explain-score"
+)
+
+// ScoreExplanation explains the sustainability score of the node a pod was bound to, compared
+// to the best scored of the other candidate nodes.
+type ScoreExplanation struct {
+	Selected NodeScoreBreakdown  `json:"selected"`
+	RunnerUp *NodeScoreBreakdown `json:"runnerUp,omitempty"`
+}
+
+// NodeScoreBreakdown holds the raw sustainability score of a node, before normalization, and
+// its weighted components. Nodes scored neutrally, because the carbon data provider was
+// unavailable, and nodes without carbon data have no components. The score is divided by the
+// carbon intensity multiplier of the CarbonSchedule windows active on the node, if any.
+type NodeScoreBreakdown struct {
+	Node             string                                `json:"node"`
+	Score            float64                               `json:"score"`
+	CarbonMultiplier float64                               `json:"carbonMultiplier,omitempty"`
+	Components       *sustainabilityprofile.ScoreBreakdown `json:"components,omitempty"`
+}
+
+// explainScore breaks down the scores of the node the pod was bound to and of the best scored
+// other node, with the weights of the pod. Ties between runners-up are broken by node name.
+func (gks *GreenScheduling) explainScore(s *preScoreState, nodeName string) ScoreExplanation {
+	explanation := ScoreExplanation{Selected: gks.breakDownScore(s, nodeName)}
+
+	runnerUp, found := "", false
+	for candidate, score := range s.scores {
+		if candidate == nodeName {
+			continue
+		}
+		if !found || score > s.scores[runnerUp] || (score == s.scores[runnerUp] && candidate < runnerUp) {
+			runnerUp, found = candidate, true
+		}
+	}
+	if found {
+		breakdown := gks.breakDownScore(s, runnerUp)
+		explanation.RunnerUp = &breakdown
+	}
+	return explanation
+}
+
+// breakDownScore returns the score of a node looked up in PreScore, and its components.
+func (gks *GreenScheduling) breakDownScore(s *preScoreState, nodeName string) NodeScoreBreakdown {
+	breakdown := NodeScoreBreakdown{Node: nodeName, Score: s.scores[nodeName], CarbonMultiplier: s.multipliers[nodeName]}
+	if profile, ok := s.profiles[nodeName]; ok {
+		components := gks.calculateScoreBreakdown(profile, s.weights)
+		breakdown.Components = &components
+	}
+	return breakdown
+}
+
+// shouldExplainScore returns whether the pod is annotated with its score breakdown, which costs
+// an update of the pod after binding.
+func (gks *GreenScheduling) shouldExplainScore(pod *v1.Pod) bool {
+	switch gks.config.ScoreBreakdown {
+	case config.AlwaysScoreBreakdown:
+		return true
+	case config.OptInScoreBreakdown:
+		explain, err := strconv.ParseBool(pod.Annotations[AnnotationKeyExplainScore])
+		return err == nil && explain
+	}
+	return false
+}
+
+// annotateScoreExplanation sets the score breakdown annotation of the pod.
+func annotateScoreExplanation(ctx context.Context, clientSet kubernetes.Interface, pod *v1.Pod, explanation ScoreExplanation) error {
+	value, err := json.Marshal(explanation)
+	if err != nil {
+		return fmt.Errorf("error encoding score breakdown: %w", err)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{AnnotationKeyScoreBreakdown: string(value)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding pod patch: %w", err)
+	}
+	_, err = clientSet.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
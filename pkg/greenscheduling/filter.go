@@ -0,0 +1,138 @@
+package greenscheduling
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+const (
+	// AnnotationKeyMaxCO2 overrides, for a pod, the maximum CO2 emissions in metric tons of the
+	// nodes it may be scheduled on over the consideration window. "0" disables the ceiling.
+	AnnotationKeyMaxCO2 = AnnotationKeyPrefix + "max-co2"
+	// AnnotationKeyMaxKwh overrides, for a pod, the maximum energy consumption in kWh of the
+	// nodes it may be scheduled on over the consideration window. "0" disables the ceiling.
+	AnnotationKeyMaxKwh = AnnotationKeyPrefix + "max-kwh"
+
+	// preFilterStateKey is the key in CycleState to the carbon ceiling computed in PreFilter.
+	preFilterStateKey = "PreFilter" + Name
+
+	// ErrReasonCO2Ceiling is the reason for nodes exceeding the CO2 ceiling of a pod.
+	ErrReasonCO2Ceiling = "node(s) exceeded the pod's CO2 emissions ceiling"
+	// ErrReasonKwhCeiling is the reason for nodes exceeding the energy ceiling of a pod.
+	ErrReasonKwhCeiling = "node(s) exceeded the pod's energy consumption ceiling"
+	// ErrReasonNoCarbonData is the reason for nodes whose carbon data has not been fetched yet,
+	// which cannot be checked against the ceiling of a pod.
+	ErrReasonNoCarbonData = "node(s) had no carbon data to check the pod's ceiling against yet"
+)
+
+var _ framework.PreFilterPlugin = &GreenScheduling{}
+var _ framework.FilterPlugin = &GreenScheduling{}
+
+// preFilterState holds the carbon ceiling of the pod being scheduled.
+type preFilterState struct {
+	ceiling CeilingConfig
+}
+
+// Clone implements the mandatory Clone interface. We don't really copy the data since
+// there is no need for that.
+func (s *preFilterState) Clone() framework.StateData {
+	return s
+}
+
+// getPreFilterState retrieves the carbon ceiling computed in PreFilter from the CycleState.
+func getPreFilterState(state *framework.CycleState) (*preFilterState, error) {
+	data, err := state.Read(preFilterStateKey)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q from cycleState: %w", preFilterStateKey, err)
+	}
+
+	s, ok := data.(*preFilterState)
+	if !ok {
+		return nil, fmt.Errorf("invalid PreFilter state, got type %T", data)
+	}
+	return s, nil
+}
+
+// PreFilter resolves the carbon ceiling of the pod from the plugin args and its annotations.
+// Filter is skipped for pods without any ceiling.
+func (gks *GreenScheduling) PreFilter(ctx context.Context, state *framework.CycleState, pod *v1.Pod) (*framework.PreFilterResult, *framework.Status) {
+	ceiling, err := podCeiling(pod, gks.config.CeilingConfig)
+	if err != nil {
+		return nil, framework.NewStatus(framework.UnschedulableAndUnresolvable, err.Error())
+	}
+	if ceiling.MaxCO2 <= 0 && ceiling.MaxKwh <= 0 {
+		return nil, framework.NewStatus(framework.Skip)
+	}
+
+	state.Write(preFilterStateKey, &preFilterState{ceiling: ceiling})
+	return nil, nil
+}
+
+// PreFilterExtensions returns nil, as the carbon ceiling does not depend on other pods.
+func (gks *GreenScheduling) PreFilterExtensions() framework.PreFilterExtensions {
+	return nil
+}
+
+// Filter rejects nodes whose CO2 emissions or energy consumption over the consideration window
+// exceed the ceiling of the pod. Nodes whose carbon data has not been fetched yet are rejected
+// as unschedulable until it is, their keys being handed over to the score cache to be fetched.
+// Nodes without a serial number or location are not managed by the plugin and not rejected.
+func (gks *GreenScheduling) Filter(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	s, err := getPreFilterState(state)
+	if err != nil {
+		return framework.AsStatus(err)
+	}
+	node := nodeInfo.Node()
+	if node == nil {
+		return framework.NewStatus(framework.Error, "node not found")
+	}
+
+	key, err := gks.getNodeKey(node.Name)
+	if err != nil {
+		return nil
+	}
+	entry, ok := gks.scoreCache.Get(key)
+	if !ok && !gks.scoreCache.Healthy() {
+		entry, ok = gks.scoreCache.GetLastKnown(key)
+	}
+	if !ok {
+		gks.scoreCache.Track(key)
+		klog.V(5).InfoS("Node has no carbon data to check the ceiling of the pod against", "pod", klog.KObj(pod), "node", node.Name)
+		return framework.NewStatus(framework.Unschedulable, ErrReasonNoCarbonData)
+	}
+
+	if s.ceiling.MaxCO2 > 0 && entry.Profile.TotalCo2 > s.ceiling.MaxCO2 {
+		klog.V(5).InfoS("Node exceeds the CO2 ceiling of the pod", "pod", klog.KObj(pod), "node", node.Name, "co2", entry.Profile.TotalCo2, "ceiling", s.ceiling.MaxCO2)
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonCO2Ceiling)
+	}
+	if s.ceiling.MaxKwh > 0 && entry.Profile.TotalKwh > s.ceiling.MaxKwh {
+		klog.V(5).InfoS("Node exceeds the energy ceiling of the pod", "pod", klog.KObj(pod), "node", node.Name, "kwh", entry.Profile.TotalKwh, "ceiling", s.ceiling.MaxKwh)
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, ErrReasonKwhCeiling)
+	}
+	return nil
+}
+
+// podCeiling returns the carbon ceiling of a pod, i.e. the configured ceiling overridden by the
+// annotations of the pod.
+func podCeiling(pod *v1.Pod, ceiling CeilingConfig) (CeilingConfig, error) {
+	for key, value := range map[string]*float64{
+		AnnotationKeyMaxCO2: &ceiling.MaxCO2,
+		AnnotationKeyMaxKwh: &ceiling.MaxKwh,
+	} {
+		annotation, ok := pod.Annotations[key]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(annotation, 64)
+		if err != nil || parsed < 0 {
+			return CeilingConfig{}, fmt.Errorf("invalid annotation %s=%q: must be a non-negative number", key, annotation)
+		}
+		*value = parsed
+	}
+	return ceiling, nil
+}
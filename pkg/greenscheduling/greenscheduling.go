@@ -2,19 +2,23 @@ package greenscheduling
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonschedule"
 	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/kubeinfo"
-	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient"
-	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
-	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/metrics"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/scorecache"
 	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
 )
 
@@ -24,6 +28,9 @@ const (
 
 	// Scaling factor to prevent low scores from being rounded to 0.
 	scoreScalingFactor = 1000
+
+	// preScoreStateKey is the key in CycleState to the sustainability scores computed in PreScore.
+	preScoreStateKey = "PreScore" + Name
 )
 
 // GreenScheduling encapsulates the dependencies and configuration needed to execute
@@ -37,23 +44,82 @@ type GreenScheduling struct {
 	// and other options required to calculate sustainability scores effectively.
 	config Config
 
-	// kubeClient is a Kubernetes API client used to interact with Kubernetes resources,
-	// retrieve node details, and obtain node labels, which can be used as identifiers
-	// to map nodes with external sustainability data.
+	// handle provides access to the scheduling framework, e.g. its parallelizer
+	// used to fetch the sustainability data of candidate nodes concurrently.
+	handle framework.Handle
+
+	// scoreCache holds the sustainability profiles and scores of nodes, keyed by serial
+	// number or location and refreshed in the background, so that scoring never waits on
+	// the provider.
+	scoreCache *scorecache.Cache
+
+	// namespaceLister reads the labels of namespaces overriding the sustainability weights.
+	namespaceLister corelisters.NamespaceLister
+
+	// kubeClient reads node details from the scheduler's informer cache and obtains
+	// node labels, which can be used as identifiers to map nodes with external
+	// sustainability data.
 	kubeClient *kubeinfo.KubeClient
 
-	// sicClient is a client for the Sustainability Information Center (SIC) API, which
-	// provides environmental data such as CO2 emissions, energy consumption, and cost.
+	// provider is the source of environmental data such as CO2 emissions, energy
+	// consumption, and cost, e.g. the Sustainability Information Center (SIC) API.
 	// This data is used to calculate sustainability scores for each node in the cluster.
-	sicClient *sicclient.Client
+	provider carbonprovider.CarbonDataProvider
+
+	// carbonSchedules holds the recurring time windows scaling the carbon intensity of nodes,
+	// e.g. during solar peaks, nil unless CarbonSchedules are enabled.
+	carbonSchedules *carbonschedule.Schedules
+}
+
+var _ framework.PreScorePlugin = &GreenScheduling{}
+var _ framework.ScorePlugin = &GreenScheduling{}
+var _ framework.PostBindPlugin = &GreenScheduling{}
+
+// preScoreState holds the raw sustainability scores looked up in PreScore, and how stale
+// the cached data behind them was, keyed by node name, along with the effective weights
+// the scores were calculated with. Nodes scored neutrally have no profile.
+type preScoreState struct {
+	scores    map[string]float64
+	staleness map[string]time.Duration
+	weights   SustainabilityWeights
+
+	// profiles holds the sustainability profiles the scores were calculated from, so that
+	// PostBind can break down the scores of the chosen node and the runner-up.
+	profiles map[string]sustainabilityprofile.SustainabilityProfile
+
+	// requestedShares holds the share of the allocatable resources of every node requested once
+	// the pod is placed on it, blended into the normalized scores in consolidation mode.
+	requestedShares map[string]float64
+
+	// multipliers holds the carbon intensity multipliers of the CarbonSchedule windows active on
+	// the nodes, which their scores were divided by. Nodes without an active window are omitted.
+	multipliers map[string]float64
 }
 
-var _ = framework.ScorePlugin(&GreenScheduling{})
+// Clone implements the mandatory Clone interface. We don't really copy the data since
+// there is no need for that.
+func (s *preScoreState) Clone() framework.StateData {
+	return s
+}
+
+// getPreScoreState retrieves the sustainability scores computed in PreScore from the CycleState.
+func getPreScoreState(state *framework.CycleState) (*preScoreState, error) {
+	data, err := state.Read(preScoreStateKey)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q from cycleState: %w", preScoreStateKey, err)
+	}
+
+	s, ok := data.(*preScoreState)
+	if !ok {
+		return nil, fmt.Errorf("invalid PreScore state, got type %T", data)
+	}
+	return s, nil
+}
 
 // New initializes a GreenScheduling plugin with the provided configuration arguments.
 // It validates the input configuration, creates necessary clients, and constructs
 // the `GreenScheduling` instance with all required dependencies and settings.
-func New(_ context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
 	// Attempt to cast the incoming object to GreenSchedulingArgs to access user-defined settings.
 	args, ok := obj.(*config.GreenSchedulingArgs)
 	if !ok {
@@ -61,52 +127,110 @@ func New(_ context.Context, obj runtime.Object, handle framework.Handle) (framew
 	}
 
 	// Validate provided arguments to ensure necessary configuration values are set correctly.
-	if err := ValidateGreenSchedulingArgs(args); err != nil {
-		return nil, fmt.Errorf("validation failed for GreenSchedulingArgs: %w", err)
+	if errs := validation.ValidateGreenSchedulingArgs(nil, args); len(errs) > 0 {
+		return nil, fmt.Errorf("validation failed for GreenSchedulingArgs: %w", errs.ToAggregate())
 	}
 
-	// Initialize the Kubernetes client to allow interaction with Kubernetes resources.
-	kubeClient, err := kubeinfo.NewKubeClient()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Kubernetes client for GreenSchedulingArgs: %w", err)
-	}
+	// Register the plugin metrics with the scheduler's metrics endpoint.
+	metrics.Register()
 
-	// Define the token configuration to authenticate with the Sustainability Information Center (SIC).
-	tokenConfig := sicclient.TokenConfig{
-		URL:          args.TokenURL,
-		ClientID:     args.ClientID,
-		ClientSecret: args.ClientSecret,
-	}
+	// Initialize the Kubernetes client on top of the framework's shared node informer,
+	// so that node lookups are served from memory instead of the API server.
+	kubeClient := kubeinfo.NewKubeClient(handle.SharedInformerFactory().Core().V1().Nodes().Lister())
 
-	// Initialize the SIC client with the provided hostname and token configuration
-	// to retrieve environmental metrics like CO2 emissions and energy usage.
-	sicClient := sicclient.New(sicclient.Config{
-		Hostname:    args.SICHostname,
-		TokenConfig: tokenConfig,
+	// Stop reporting the per-node metrics of deleted nodes.
+	handle.SharedInformerFactory().Core().V1().Nodes().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: deleteNodeMetrics,
 	})
 
+	// Initialize the configured carbon data provider to retrieve environmental metrics
+	// like CO2 emissions and energy usage.
+	provider, err := carbonprovider.New(ctx, args, handle)
+	if err != nil {
+		return nil, fmt.Errorf("error creating carbon data provider: %w", err)
+	}
+
 	// Build the Config object that will encapsulate all plugin-specific settings,
 	// including time series settings and sustainability metric weights.
-	config := Config{
+	config := newConfig(args)
+
+	// Create a new instance of GreenScheduling with all necessary clients and configurations.
+	gks := &GreenScheduling{
+		kubeClient:      kubeClient,                                                       // Client for reading nodes from the informer cache
+		namespaceLister: handle.SharedInformerFactory().Core().V1().Namespaces().Lister(), // Lister for namespace weight overrides
+		provider:        provider,                                                         // Source of environmental data
+		config:          config,                                                           // Plugin configuration settings
+		handle:          handle,                                                           // Framework handle
+	}
+
+	// Start the score cache, refreshing sustainability data in the background for as long
+	// as the scheduler runs.
+	gks.scoreCache = scorecache.New(config.CacheConfig.TTL, config.CacheConfig.RefreshPeriod, gks.fetchCacheEntries)
+	go gks.scoreCache.Run(ctx)
+
+	// Watch the CarbonSchedules scaling the scores of nodes during their time windows.
+	if args.EnableCarbonSchedules {
+		gks.carbonSchedules, err = carbonschedule.NewFromConfig(ctx, handle.KubeConfig())
+		if err != nil {
+			return nil, fmt.Errorf("error watching CarbonSchedules: %w", err)
+		}
+	}
+
+	// Release deferred delay-tolerant pods once carbon emissions drop or their deadline approaches.
+	if config.DeferralConfig.CO2Threshold > 0 {
+		go gks.runDeferral(ctx)
+	}
+
+	return gks, nil
+}
+
+// newConfig converts the plugin arguments to the plugin configuration.
+func newConfig(args *config.GreenSchedulingArgs) Config {
+	return Config{
 		TimeSeriesConfig: TimeSeriesConfig{
 			SeriesInterval: args.TimeSeriesInterval, // Interval for time series data (e.g., hourly, daily)
 			DaysToConsider: args.ConsiderationDays,  // Number of days to look back for sustainability data
 		},
 		SustainabilityWeights: SustainabilityWeights{
-			args.CO2DecayWeight, // Weight for decaying CO2 emissions
-			args.TotalCO2Weight, // Weight for total CO2 emissions
-			args.CostWeight,     // Weight for cost in sustainability score calculation
-			args.DecayRate,      // Rate at which CO2 impact decays over time
+			args.CO2DecayWeight,    // Weight for decaying CO2 emissions
+			args.TotalCO2Weight,    // Weight for total CO2 emissions
+			args.CostWeight,        // Weight for cost in sustainability score calculation
+			args.DecayRate,         // Rate at which CO2 impact decays over time
+			args.EnergyDecayWeight, // Weight for decaying energy consumption
+			args.EnergyWeight,      // Weight for total energy consumption
+		},
+		DecayConfig: DecayConfig{
+			Model:    args.DecayModel,                                             // Model weighing emission data points by their age
+			Window:   time.Duration(args.DecayWindowHours * float64(time.Hour)),   // Window of the Linear and SlidingWindow models
+			HalfLife: time.Duration(args.DecayHalfLifeHours * float64(time.Hour)), // Half-life of the EWMA model
+		},
+		EmbodiedCarbonConfig: EmbodiedCarbonConfig{
+			Weight:      args.EmbodiedCarbon.Weight,                                                   // Weight for embodied carbon
+			Lifetime:    time.Duration(args.EmbodiedCarbon.LifetimeYears * float64(365*24*time.Hour)), // Lifetime embodied carbon is amortised over
+			PreferNewer: args.EmbodiedCarbon.Preference == config.NewerEmbodiedCarbonPreference,       // Whether newer hardware is favoured
+			Footprints:  args.EmbodiedCarbon.Footprints,                                               // Embodied carbon per hardware model
+		},
+		ScoreNormalization: args.ScoreNormalization, // Strategy scaling raw scores to the framework's score range
+		SerialNumLabel:     args.SerialNumLabel,     // Node label to identify the serial number for carbon data lookup
+		CacheConfig: CacheConfig{
+			TTL:           time.Duration(args.ScoreCacheTTLSeconds) * time.Second,           // Maximum age of cached scores
+			RefreshPeriod: time.Duration(args.ScoreCacheRefreshPeriodSeconds) * time.Second, // Interval between cache refreshes
+		},
+		DeferralConfig: DeferralConfig{
+			CO2Threshold:   args.CarbonDeferral.CO2Threshold,                                       // CO2 trend above which delay-tolerant pods wait
+			CheckPeriod:    time.Duration(args.CarbonDeferral.CheckPeriodSeconds) * time.Second,    // Interval between re-evaluations of deferred pods
+			DeadlineMargin: time.Duration(args.CarbonDeferral.DeadlineMarginSeconds) * time.Second, // Time before the deadline deferred pods are released
+		},
+		CeilingConfig: CeilingConfig{
+			MaxCO2: args.CarbonCeiling.MaxCO2, // Maximum CO2 emissions of the nodes pods may be scheduled on
+			MaxKwh: args.CarbonCeiling.MaxKwh, // Maximum energy consumption of the nodes pods may be scheduled on
 		},
-		SerialNumLabel: args.SerialNumLabel, // Node label to identify the serial number for SIC lookup
+		LocationFallback: carbonprovider.NewLocationFallback(&args.LocationFallback), // SIC locations of nodes without a serial number
+		ConsolidationConfig: ConsolidationConfig{
+			PackingWeight: args.Consolidation.PackingWeight, // Share of the score given to bin-packing
+		},
+		ScoreBreakdown: args.ScoreBreakdown, // Which pods are annotated with their score breakdown
 	}
-
-	// Return a new instance of GreenScheduling with all necessary clients and configurations.
-	return &GreenScheduling{
-		kubeClient: kubeClient, // Client for interacting with Kubernetes resources
-		sicClient:  sicClient,  // Client for accessing environmental data from SIC
-		config:     config,     // Plugin configuration settings
-	}, nil
 }
 
 // Name returns name of the plugin. It is used in logs, etc.
@@ -114,43 +238,124 @@ func (n *GreenScheduling) Name() string {
 	return Name
 }
 
-// Score computes the sustainability score for a given node.
-func (gks *GreenScheduling) Score(ctx context.Context, state *framework.CycleState, p *v1.Pod, nodeName string) (int64, *framework.Status) {
-	// Retrieve the node's serial number
-	serialNum, err := gks.getNodeSerialNum(nodeName)
-	if err != nil {
-		if errors.Is(err, kubeinfo.ErrNodeNotFound) || errors.Is(err, kubeinfo.ErrLabelNotFound) {
-			klog.Infof("Node %s missing required label '%s': %v", nodeName, gks.config.SerialNumLabel, err)
-			return 0, framework.NewStatus(framework.Success)
+// PreScore resolves the serial numbers, or locations, of all candidate nodes and looks up their
+// sustainability scores in the score cache, storing them in the CycleState so that Score only has
+// to perform an in-memory lookup. Keys seen for the first time are handed over to the cache,
+// which fetches their carbon data in the background; until then such nodes score 0.
+func (gks *GreenScheduling) PreScore(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodes []*framework.NodeInfo) *framework.Status {
+	s := &preScoreState{
+		scores:    make(map[string]float64, len(nodes)),
+		staleness: make(map[string]time.Duration, len(nodes)),
+		weights:   gks.effectiveWeights(pod),
+		profiles:  make(map[string]sustainabilityprofile.SustainabilityProfile, len(nodes)),
+	}
+	// Cached scores are calculated with the configured weights, so pods overriding them
+	// are scored from the cached profiles instead.
+	overridden := s.weights != gks.config.SustainabilityWeights
+	if overridden {
+		klog.V(4).InfoS("Using overridden sustainability weights", "pod", klog.KObj(pod), "weights", s.weights)
+	}
+
+	// Map every labelled node to its serial number, or the location it falls back to. Nodes
+	// without either keep the default score of 0, as they cannot be matched with any carbon data.
+	nodeKeys := make(map[string]string, len(nodes))
+	for _, nodeInfo := range nodes {
+		node := nodeInfo.Node()
+		if node == nil {
+			continue
 		}
-		klog.Errorf("Error retrieving label '%s' for node %s: %v", nodeName, gks.config.SerialNumLabel, err)
-		return 0, framework.AsStatus(err)
+		key, ok := carbonprovider.NodeKey(node.Labels, gks.config.SerialNumLabel, gks.config.LocationFallback)
+		if !ok {
+			klog.Infof("Node %s missing required label '%s' and without a location fallback", node.Name, gks.config.SerialNumLabel)
+			continue
+		}
+		nodeKeys[node.Name] = key
 	}
 
-	// Build SIC parameters
-	params, err := gks.buildSicParams(serialNum)
-	if err != nil {
-		klog.Errorf("Error creating filter parameters for node %s: %v", nodeName, err)
-		return 0, framework.AsStatus(err)
+	if gks.config.ConsolidationConfig.PackingWeight > 0 {
+		s.requestedShares = requestedShares(pod, nodes)
 	}
 
-	// Fetch usage data from SIC
-	usageByEntity, usageSeries, err := gks.fetchSicData(params)
-	if err != nil {
-		klog.Errorf("Error fetching SIC data for node %s: %v", nodeName, err)
-		return 0, framework.AsStatus(err)
+	uniqueKeys := sets.New[string]()
+	for _, key := range nodeKeys {
+		uniqueKeys.Insert(key)
+	}
+	gks.scoreCache.Track(sets.List(uniqueKeys)...)
+
+	// While the carbon data provider is unavailable, e.g. because the SIC circuit breaker is
+	// open, fall back to the last known scores, and score nodes without any data neutrally
+	// rather than penalising them.
+	healthy := gks.scoreCache.Healthy()
+	var unscored []string
+	now := time.Now()
+	for nodeName, key := range nodeKeys {
+		entry, ok := gks.scoreCache.Get(key)
+		result := "hit"
+		if !ok && !healthy {
+			entry, ok = gks.scoreCache.GetLastKnown(key)
+			result = "stale"
+		}
+		if !ok {
+			metrics.ScoreCacheLookups.WithLabelValues("miss").Inc()
+			metrics.DeleteNode(nodeName)
+			klog.V(4).InfoS("No cached sustainability score for node yet", "node", nodeName, "key", key)
+			unscored = append(unscored, nodeName)
+			continue
+		}
+		metrics.ScoreCacheLookups.WithLabelValues(result).Inc()
+		metrics.NodeSustainabilityScore.WithLabelValues(nodeName).Set(entry.Score)
+		metrics.NodeCO2.WithLabelValues(nodeName).Set(entry.Profile.TotalCo2)
+		metrics.NodeCost.WithLabelValues(nodeName).Set(entry.Profile.TotalCost)
+		s.scores[nodeName] = entry.Score
+		s.profiles[nodeName] = entry.Profile
+		if overridden {
+			s.scores[nodeName] = gks.calculateSustainabilityScore(entry.Profile, s.weights)
+		}
+		s.staleness[nodeName] = entry.Age(now)
+	}
+	if !healthy && len(unscored) > 0 {
+		neutral := neutralScore(s.scores)
+		klog.V(4).InfoS("Carbon data provider unavailable, using neutral sustainability score", "pod", klog.KObj(pod), "nodes", len(unscored), "score", neutral)
+		for _, nodeName := range unscored {
+			s.scores[nodeName] = neutral
+		}
 	}
 
-	// Build emission data points
-	dataPoints, err := gks.buildEmissionDataPoints(usageSeries)
+	// Scale the scores by the carbon intensity of the time windows active on the nodes, so that
+	// nodes within a renewable window are favoured. Lower multipliers mean greener energy.
+	if gks.carbonSchedules != nil {
+		candidates := make([]*v1.Node, 0, len(nodes))
+		for _, nodeInfo := range nodes {
+			if node := nodeInfo.Node(); node != nil {
+				candidates = append(candidates, node)
+			}
+		}
+		s.multipliers = gks.carbonSchedules.Multipliers(candidates, now)
+		for nodeName, multiplier := range s.multipliers {
+			if score, ok := s.scores[nodeName]; ok {
+				s.scores[nodeName] = score / multiplier
+			}
+		}
+	}
+
+	state.Write(preScoreStateKey, s)
+	return nil
+}
+
+// Score computes the sustainability score for a given node.
+func (gks *GreenScheduling) Score(ctx context.Context, state *framework.CycleState, p *v1.Pod, nodeName string) (int64, *framework.Status) {
+	s, err := getPreScoreState(state)
 	if err != nil {
-		klog.Errorf("Error building emission data points for node %s: %v", nodeName, err)
+		klog.Errorf("Error reading PreScore state for pod %s: %v", klog.KObj(p), err)
 		return 0, framework.AsStatus(err)
 	}
 
-	// Calculate the sustainability score
-	score := gks.calculateSustainabilityScore(usageByEntity, dataPoints)
-	klog.Infof("Calculated sustainability score for node %s with serial number %s: %f", nodeName, serialNum, score)
+	// Nodes without a serial number or without carbon data have no score entry.
+	score, ok := s.scores[nodeName]
+	if !ok {
+		return 0, framework.NewStatus(framework.Success)
+	}
+	klog.V(4).InfoS("Using cached sustainability score", "pod", klog.KObj(p), "node", nodeName, "score", score, "staleness", s.staleness[nodeName])
 
 	// Scale the score to preserve precision and return as an integer
 	scaledScore := int64(score * scoreScalingFactor)
@@ -159,81 +364,200 @@ func (gks *GreenScheduling) Score(ctx context.Context, state *framework.CycleSta
 	return scaledScore, framework.NewStatus(framework.Success)
 }
 
-// getNodeSerialNum retrieves the serial number label from the node.
-func (gks *GreenScheduling) getNodeSerialNum(nodeName string) (string, error) {
-	serialNum, err := gks.kubeClient.GetNodeLabelValue(nodeName, gks.config.SerialNumLabel)
+// PostBind records the sustainability score of the node the pod was bound to, relative to the
+// best scored candidate node, to track the carbon impact of scheduling decisions, and logs the
+// breakdown of the scores of the node and the runner-up, annotating the pod with it if enabled.
+func (gks *GreenScheduling) PostBind(ctx context.Context, state *framework.CycleState, p *v1.Pod, nodeName string) {
+	s, err := getPreScoreState(state)
 	if err != nil {
-		return "", err
+		// PreScore is skipped when there is a single feasible node.
+		klog.V(5).InfoS("No PreScore state for bound pod", "pod", klog.KObj(p), "err", err)
+		return
 	}
 
-	return serialNum, nil
-}
+	explanation := gks.explainScore(s, nodeName)
+	klog.V(4).InfoS("Sustainability score breakdown", "pod", klog.KObj(p), "node", nodeName, "selected", explanation.Selected, "runnerUp", explanation.RunnerUp)
+	if gks.shouldExplainScore(p) {
+		if err := annotateScoreExplanation(ctx, gks.handle.ClientSet(), p, explanation); err != nil {
+			klog.ErrorS(err, "Unable to annotate pod with its sustainability score breakdown", "pod", klog.KObj(p))
+		}
+	}
 
-// buildSicParams constructs SIC parameters based on the serial number.
-func (gks *GreenScheduling) buildSicParams(serialNum string) (*sicparams.Params, error) {
-	filter, err := sicparams.NewFilter(sicparams.FilterKeyEntitySerialNum, sicparams.FilterOperatorEquals, serialNum)
-	if err != nil {
-		return sicparams.New(), fmt.Errorf("error creating filter: %w", err)
+	var best float64
+	for _, score := range s.scores {
+		if score > best {
+			best = score
+		}
+	}
+	if best <= 0 {
+		return
 	}
+	metrics.ScheduledNodeScoreRatio.Observe(s.scores[nodeName] / best)
+}
 
-	return sicparams.New().AddFilter(filter), nil
+// deleteNodeMetrics stops reporting the per-node metrics of a deleted node.
+func deleteNodeMetrics(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if node, ok := obj.(*v1.Node); ok {
+		metrics.DeleteNode(node.Name)
+	}
 }
 
-// fetchSicData retrieves usage data and time series data from the SIC client.
-func (gks *GreenScheduling) fetchSicData(params *sicparams.Params) (*sicresponse.UsageByEntityResponse, *sicresponse.UsageSeriesResponse, error) {
-	startTime := time.Now().AddDate(0, 0, -int(gks.config.TimeSeriesConfig.DaysToConsider)).Format(time.RFC3339)
-	endTime := time.Now().Format(time.RFC3339)
+// neutralScore returns the average of the given scores, or 0 if there are none.
+func neutralScore(scores map[string]float64) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, score := range scores {
+		sum += score
+	}
+	return sum / float64(len(scores))
+}
 
-	usageByEntity, err := gks.sicClient.GetUsageByEntity(startTime, endTime, params)
+// getNodeKey returns the key of the carbon data of a node: the value of its serial number label,
+// or, for nodes without it, the key of the SIC location its zone or region label maps to.
+func (gks *GreenScheduling) getNodeKey(nodeName string) (string, error) {
+	labels, err := gks.kubeClient.GetNodeLabels(nodeName)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error fetching usage by entity data: %w", err)
+		return "", err
+	}
+	key, ok := carbonprovider.NodeKey(labels, gks.config.SerialNumLabel, gks.config.LocationFallback)
+	if !ok {
+		return "", kubeinfo.ErrLabelNotFound
 	}
+	return key, nil
+}
 
-	usageSeries, err := gks.sicClient.GetUsageSeries(startTime, endTime, gks.config.TimeSeriesConfig.SeriesInterval, params)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error fetching usage series data: %w", err)
+// fetchCacheEntries fetches the carbon data of the given serial numbers and locations from the
+// provider and returns a score cache entry for every key known to it.
+func (gks *GreenScheduling) fetchCacheEntries(ctx context.Context, keys []string) (map[string]scorecache.Entry, error) {
+	entries := make(map[string]scorecache.Entry, len(keys))
+	var serialNums []string
+	var locations []carbonprovider.Location
+	for _, key := range keys {
+		if location, ok := carbonprovider.ParseLocationKey(key); ok {
+			locations = append(locations, location)
+		} else {
+			serialNums = append(serialNums, key)
+		}
 	}
 
-	return usageByEntity, usageSeries, nil
-}
+	startTime, endTime := gks.timeRange()
+	now := time.Now()
+	if len(serialNums) > 0 {
+		carbonData, err := gks.provider.GetCarbonData(ctx, serialNums, startTime, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching carbon data: %w", err)
+		}
+		for serialNum, data := range carbonData {
+			entries[serialNum] = gks.newCacheEntry(data, now)
+			klog.Infof("Calculated sustainability score for serial number %s: %f", serialNum, entries[serialNum].Score)
+		}
+	}
 
-// buildEmissionDataPoints processes usage series data into emission data points.
-func (gks *GreenScheduling) buildEmissionDataPoints(usageSeries *sicresponse.UsageSeriesResponse) ([]sustainabilityprofile.EmissionDataPoint, error) {
-	var dataPoints []sustainabilityprofile.EmissionDataPoint
-	for _, entity := range usageSeries.Items {
-		timeBucket, err := entity.GetTimeBucket()
+	if len(locations) > 0 {
+		provider, ok := gks.provider.(carbonprovider.LocationCarbonDataProvider)
+		if !ok {
+			return entries, nil
+		}
+		carbonData, err := provider.GetLocationCarbonData(ctx, locations, startTime, endTime)
 		if err != nil {
-			return nil, fmt.Errorf("error retrieving time bucket: %w", err)
+			return nil, fmt.Errorf("error fetching location carbon data: %w", err)
+		}
+		for location, data := range carbonData {
+			entries[location.Key()] = gks.newCacheEntry(data, now)
+			klog.Infof("Calculated sustainability score for location %s: %f", location.Key(), entries[location.Key()].Score)
 		}
-		dataPoints = append(dataPoints, sustainabilityprofile.EmissionDataPoint{
-			Co2:  entity.GetCo2eMetricTon(),
-			Time: timeBucket,
-		})
 	}
-	return dataPoints, nil
+
+	return entries, nil
 }
 
-// calculateSustainabilityScore calculates the sustainability score from entity data and emission data points.
-func (gks *GreenScheduling) calculateSustainabilityScore(usageByEntity *sicresponse.UsageByEntityResponse, dataPoints []sustainabilityprofile.EmissionDataPoint) float64 {
-	// Check if the usageByEntity response contains items
-	if len(usageByEntity.Items) == 0 {
-		klog.Warning("UsageByEntity response is empty")
-		return 0.0
+// newCacheEntry builds the score cache entry of carbon data fetched at the given time.
+func (gks *GreenScheduling) newCacheEntry(data carbonprovider.CarbonData, now time.Time) scorecache.Entry {
+	profile := gks.buildSustainabilityProfile(data)
+	return scorecache.Entry{
+		Profile:   profile,
+		Score:     gks.calculateSustainabilityScore(profile, gks.config.SustainabilityWeights),
+		UpdatedAt: now,
 	}
+}
 
-	sData := sustainabilityprofile.New(
-		dataPoints,
-		usageByEntity.Items[0].GetCo2eMetricTon(),
-		usageByEntity.Items[0].GetCostUsd(),
-	)
-	return sData.CalculateScore(
-		sustainabilityprofile.NewSustainabilityWeights(
-			gks.config.SustainabilityWeights.CO2DecayWeight,
-			gks.config.SustainabilityWeights.TotalCO2Weight,
-			gks.config.SustainabilityWeights.CostWeight,
-			gks.config.SustainabilityWeights.DecayRate,
-		),
+// timeRange returns the start and end time of the window carbon data is fetched for.
+func (gks *GreenScheduling) timeRange() (time.Time, time.Time) {
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -int(gks.config.TimeSeriesConfig.DaysToConsider))
+	return startTime, endTime
+}
+
+// buildSustainabilityProfile builds the sustainability profile of a node from its carbon data.
+func (gks *GreenScheduling) buildSustainabilityProfile(data carbonprovider.CarbonData) sustainabilityprofile.SustainabilityProfile {
+	profile := sustainabilityprofile.New(
+		data.Emissions,
+		data.TotalCo2,
+		data.TotalCost,
+		data.TotalKwh,
 	)
+	profile.Hardware = data.Hardware
+	profile.Embodied = gks.embodiedCarbon(data.Hardware, time.Now())
+	return profile
+}
+
+// embodiedCarbon returns the embodied carbon of the hardware, or nil if its footprint or
+// manufacture time is unknown.
+func (gks *GreenScheduling) embodiedCarbon(hardware sustainabilityprofile.Hardware, now time.Time) *sustainabilityprofile.EmbodiedCarbon {
+	footprint, ok := gks.config.EmbodiedCarbonConfig.Footprints[hardware.Make+"/"+hardware.Model]
+	if !ok || hardware.ManufacturedAt.IsZero() {
+		return nil
+	}
+	embodied := sustainabilityprofile.NewEmbodiedCarbon(footprint, gks.config.EmbodiedCarbonConfig.Lifetime, now.Sub(hardware.ManufacturedAt))
+	return &embodied
+}
+
+// calculateSustainabilityScore calculates the sustainability score of a sustainability profile
+// with the given weights.
+func (gks *GreenScheduling) calculateSustainabilityScore(profile sustainabilityprofile.SustainabilityProfile, weights SustainabilityWeights) float64 {
+	return profile.CalculateScore(gks.profileWeights(weights))
+}
+
+// calculateScoreBreakdown calculates the weighted components of the sustainability score of a
+// sustainability profile with the given weights.
+func (gks *GreenScheduling) calculateScoreBreakdown(profile sustainabilityprofile.SustainabilityProfile, weights SustainabilityWeights) sustainabilityprofile.ScoreBreakdown {
+	return profile.CalculateScoreBreakdown(gks.profileWeights(weights))
+}
+
+// profileWeights converts the given weights, along with the configured decay model and embodied
+// carbon settings, to the weights of sustainability profiles.
+func (gks *GreenScheduling) profileWeights(weights SustainabilityWeights) sustainabilityprofile.SustainabilityWeights {
+	return sustainabilityprofile.NewSustainabilityWeights(
+		weights.CO2DecayWeight,
+		weights.TotalCO2Weight,
+		weights.CostWeight,
+		weights.DecayRate,
+		weights.EnergyDecayWeight,
+		weights.EnergyWeight,
+	).WithDecayModel(gks.decayModel(weights.DecayRate)).
+		WithEmbodiedCarbon(gks.config.EmbodiedCarbonConfig.Weight, gks.config.EmbodiedCarbonConfig.PreferNewer)
+}
+
+// decayModel returns the configured decay model, using the given decay rate for the Exponential
+// and Logistic models.
+func (gks *GreenScheduling) decayModel(decayRate float64) sustainabilityprofile.DecayModel {
+	switch gks.config.DecayConfig.Model {
+	case config.ExponentialDecayModel:
+		return sustainabilityprofile.NewExponentialDecay(decayRate)
+	case config.LinearDecayModel:
+		return sustainabilityprofile.NewLinearDecay(gks.config.DecayConfig.Window)
+	case config.SlidingWindowDecayModel:
+		return sustainabilityprofile.NewSlidingWindowDecay(gks.config.DecayConfig.Window)
+	case config.EWMADecayModel:
+		return sustainabilityprofile.NewEWMADecay(gks.config.DecayConfig.HalfLife)
+	default:
+		return sustainabilityprofile.NewLogisticDecay(decayRate)
+	}
 }
 
 // ScoreExtensions of the GreenScheduling plugin to implement the framework.ScoreExtensions interface.
@@ -241,18 +565,16 @@ func (gks *GreenScheduling) ScoreExtensions() framework.ScoreExtensions {
 	return gks
 }
 
-// NormalizeScore to scale every score to the framework.MaxNodeScore.
+// NormalizeScore scales every score to the framework.MaxNodeScore with the configured normalization strategy.
+// In consolidation mode, the normalized scores are then blended with the bin-packing pressure of the nodes.
 func (gks *GreenScheduling) NormalizeScore(ctx context.Context, state *framework.CycleState, pod *v1.Pod, scores framework.NodeScoreList) *framework.Status {
-	var higherScore int64
-	for _, node := range scores {
-		if higherScore < node.Score {
-			higherScore = node.Score
+	normalizeScores(gks.config.ScoreNormalization, scores)
+	if packingWeight := gks.config.ConsolidationConfig.PackingWeight; packingWeight > 0 {
+		s, err := getPreScoreState(state)
+		if err != nil {
+			return framework.AsStatus(err)
 		}
+		consolidate(scores, s.requestedShares, packingWeight)
 	}
-
-	for i, node := range scores {
-		scores[i].Score = node.Score * framework.MaxNodeScore / higherScore
-	}
-
 	return nil
 }
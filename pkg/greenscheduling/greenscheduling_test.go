@@ -0,0 +1,680 @@
+package greenscheduling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonschedule"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/kubeinfo"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/metrics"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/scorecache"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+const testSerialNumLabel = "serial-number"
+
+// newTestPlugin creates a GreenScheduling plugin whose nodes carry the given serial numbers,
+// and whose score cache holds a single emission data point per serial number.
+func newTestPlugin(t *testing.T, config Config, nodeSerialNums map[string]string, serialNumCO2 map[string]float64) *GreenScheduling {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for nodeName, serialNum := range nodeSerialNums {
+		if err := indexer.Add(newTestNode(nodeName, serialNum)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "training", Labels: map[string]string{
+		AnnotationKeyTotalCO2Weight: "2",
+		AnnotationKeyCostWeight:     "0",
+	}}}
+	if err := namespaceIndexer.Add(namespace); err != nil {
+		t.Fatal(err)
+	}
+
+	config.SerialNumLabel = testSerialNumLabel
+	gks := &GreenScheduling{
+		config:          config,
+		kubeClient:      kubeinfo.NewKubeClient(corelisters.NewNodeLister(indexer)),
+		namespaceLister: corelisters.NewNamespaceLister(namespaceIndexer),
+	}
+	gks.scoreCache = scorecache.New(time.Hour, time.Hour, func(_ context.Context, serialNums []string) (map[string]scorecache.Entry, error) {
+		entries := map[string]scorecache.Entry{}
+		for _, serialNum := range serialNums {
+			if co2, ok := serialNumCO2[serialNum]; ok {
+				emissions := []sustainabilityprofile.EmissionDataPoint{sustainabilityprofile.NewEmissionDataPoint(co2, 0, time.Now())}
+				profile := sustainabilityprofile.New(emissions, co2, 0, 0)
+				entries[serialNum] = scorecache.Entry{Profile: profile, Score: gks.calculateSustainabilityScore(profile, config.SustainabilityWeights), UpdatedAt: time.Now()}
+			}
+		}
+		return entries, nil
+	})
+	for _, serialNum := range nodeSerialNums {
+		gks.scoreCache.Track(serialNum)
+	}
+	gks.scoreCache.Refresh(context.Background())
+	return gks
+}
+
+// newTestNode creates a node labelled with the given serial number.
+func newTestNode(name, serialNum string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{testSerialNumLabel: serialNum}}}
+}
+
+func TestPermitDefersDelayTolerantPods(t *testing.T) {
+	config := Config{DeferralConfig: DeferralConfig{CO2Threshold: 1, DeadlineMargin: time.Minute}}
+	gks := newTestPlugin(t, config,
+		map[string]string{"dirty": "a", "clean": "b", "unknown": "c"},
+		map[string]float64{"a": 10, "b": 0.5})
+
+	deadline := time.Now().Add(time.Hour).Format(time.RFC3339)
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		node        string
+		want        framework.Code
+	}{
+		{
+			name: "not delay-tolerant",
+			node: "dirty",
+			want: framework.Success,
+		},
+		{
+			name:        "delay-tolerant on a high carbon node",
+			annotations: map[string]string{AnnotationKeyDelayTolerant: "true", AnnotationKeyDeadline: deadline},
+			node:        "dirty",
+			want:        framework.Wait,
+		},
+		{
+			name:        "delay-tolerant on a low carbon node",
+			annotations: map[string]string{AnnotationKeyDelayTolerant: "true", AnnotationKeyDeadline: deadline},
+			node:        "clean",
+			want:        framework.Success,
+		},
+		{
+			name:        "delay-tolerant on a node without carbon data",
+			annotations: map[string]string{AnnotationKeyDelayTolerant: "true", AnnotationKeyDeadline: deadline},
+			node:        "unknown",
+			want:        framework.Success,
+		},
+		{
+			name:        "deadline within the margin",
+			annotations: map[string]string{AnnotationKeyDelayTolerant: "true", AnnotationKeyDeadline: time.Now().Add(30 * time.Second).Format(time.RFC3339)},
+			node:        "dirty",
+			want:        framework.Success,
+		},
+		{
+			name:        "missing deadline",
+			annotations: map[string]string{AnnotationKeyDelayTolerant: "true"},
+			node:        "dirty",
+			want:        framework.Success,
+		},
+		{
+			name:        "invalid deadline",
+			annotations: map[string]string{AnnotationKeyDelayTolerant: "true", AnnotationKeyDeadline: "tomorrow"},
+			node:        "dirty",
+			want:        framework.Success,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Annotations: tt.annotations}}
+			status, waitTime := gks.Permit(context.Background(), framework.NewCycleState(), pod, tt.node)
+			if status.Code() != tt.want {
+				t.Fatalf("expected status %v, got %v", tt.want, status.Code())
+			}
+			if tt.want == framework.Wait && (waitTime <= 0 || waitTime > time.Hour-time.Minute) {
+				t.Errorf("expected the pod to wait until the deadline margin, got %v", waitTime)
+			}
+		})
+	}
+}
+
+func TestCarbonSchedules(t *testing.T) {
+	config := Config{
+		SustainabilityWeights: SustainabilityWeights{TotalCO2Weight: 1},
+		DeferralConfig:        DeferralConfig{CO2Threshold: 1, DeadlineMargin: time.Minute},
+	}
+	gks := newTestPlugin(t, config,
+		map[string]string{"solar": "a", "dirty": "b"},
+		map[string]float64{"a": 10, "b": 10})
+
+	// A window spanning the whole day, so that it is active whenever the test runs.
+	schedule := &schedv1alpha1.CarbonSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "solar"},
+		Spec: schedv1alpha1.CarbonScheduleSpec{
+			NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{testSerialNumLabel: "a"}},
+			Windows: []schedv1alpha1.CarbonWindow{
+				{Start: "00:00", End: "00:00", CarbonIntensityMultiplier: resource.MustParse("0.5")},
+			},
+		},
+	}
+	gks.carbonSchedules = carbonschedule.New()
+	gks.carbonSchedules.OnAdd(schedule, true)
+
+	var nodeInfos []*framework.NodeInfo
+	for nodeName, serialNum := range map[string]string{"solar": "a", "dirty": "b"} {
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(newTestNode(nodeName, serialNum))
+		nodeInfos = append(nodeInfos, nodeInfo)
+	}
+	state := framework.NewCycleState()
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Annotations: map[string]string{
+		AnnotationKeyDelayTolerant: "true",
+		AnnotationKeyDeadline:      time.Now().Add(time.Hour).Format(time.RFC3339),
+	}}}
+	if status := gks.PreScore(context.Background(), state, pod, nodeInfos); !status.IsSuccess() {
+		t.Fatalf("unexpected PreScore status: %v", status)
+	}
+	s, err := getPreScoreState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.scores["solar"], 2*s.scores["dirty"]; got != want {
+		t.Errorf("expected the score of the node within the window to be doubled to %v, got %v", want, got)
+	}
+	if breakdown := gks.breakDownScore(s, "solar"); breakdown.CarbonMultiplier != 0.5 {
+		t.Errorf("expected the score breakdown to hold the multiplier 0.5, got %v", breakdown.CarbonMultiplier)
+	}
+
+	// Pods are not deferred on nodes within a green window.
+	if status, _ := gks.Permit(context.Background(), state, pod, "solar"); status.Code() != framework.Success {
+		t.Errorf("expected the pod not to be deferred within a green window, got %v", status.Code())
+	}
+	if status, _ := gks.Permit(context.Background(), state, pod, "dirty"); status.Code() != framework.Wait {
+		t.Errorf("expected the pod to be deferred outside a green window, got %v", status.Code())
+	}
+}
+
+func TestFilterCarbonCeiling(t *testing.T) {
+	config := Config{CeilingConfig: CeilingConfig{MaxCO2: 5}}
+	gks := newTestPlugin(t, config,
+		map[string]string{"dirty": "a", "clean": "b", "unknown": "c"},
+		map[string]float64{"a": 10, "b": 0.5})
+
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		node            string
+		wantPreFilter   framework.Code
+		wantFilter      framework.Code
+		wantFilterError string
+	}{
+		{
+			name:            "node above the configured ceiling",
+			node:            "dirty",
+			wantFilter:      framework.UnschedulableAndUnresolvable,
+			wantFilterError: ErrReasonCO2Ceiling,
+		},
+		{
+			name: "node below the configured ceiling",
+			node: "clean",
+		},
+		{
+			name:            "node without carbon data",
+			node:            "unknown",
+			wantFilter:      framework.Unschedulable,
+			wantFilterError: ErrReasonNoCarbonData,
+		},
+		{
+			name:        "ceiling raised by annotation",
+			annotations: map[string]string{AnnotationKeyMaxCO2: "20"},
+			node:        "dirty",
+		},
+		{
+			name:            "ceiling lowered by annotation",
+			annotations:     map[string]string{AnnotationKeyMaxCO2: "0.1"},
+			node:            "clean",
+			wantFilter:      framework.UnschedulableAndUnresolvable,
+			wantFilterError: ErrReasonCO2Ceiling,
+		},
+		{
+			name:          "ceiling disabled by annotation",
+			annotations:   map[string]string{AnnotationKeyMaxCO2: "0"},
+			node:          "dirty",
+			wantPreFilter: framework.Skip,
+		},
+		{
+			name:          "invalid annotation",
+			annotations:   map[string]string{AnnotationKeyMaxCO2: "low"},
+			node:          "clean",
+			wantPreFilter: framework.UnschedulableAndUnresolvable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Annotations: tt.annotations}}
+			state := framework.NewCycleState()
+			if _, status := gks.PreFilter(context.Background(), state, pod); status.Code() != tt.wantPreFilter {
+				t.Fatalf("expected PreFilter status %v, got %v", tt.wantPreFilter, status.Code())
+			}
+			if tt.wantPreFilter != framework.Success {
+				return
+			}
+
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: tt.node}})
+			status := gks.Filter(context.Background(), state, pod, nodeInfo)
+			if status.Code() != tt.wantFilter {
+				t.Fatalf("expected Filter status %v, got %v", tt.wantFilter, status.Code())
+			}
+			if tt.wantFilterError != "" && status.Message() != tt.wantFilterError {
+				t.Errorf("expected Filter reason %q, got %q", tt.wantFilterError, status.Message())
+			}
+		})
+	}
+}
+
+func TestPreScoreWeightOverrides(t *testing.T) {
+	config := Config{SustainabilityWeights: SustainabilityWeights{TotalCO2Weight: 1, CostWeight: 1, DecayRate: 0.05}}
+	gks := newTestPlugin(t, config, map[string]string{"node": "a"}, map[string]float64{"a": 1})
+
+	tests := []struct {
+		name        string
+		namespace   string
+		annotations map[string]string
+		want        SustainabilityWeights
+		wantScore   float64
+	}{
+		{
+			name:      "configured weights",
+			namespace: "default",
+			want:      config.SustainabilityWeights,
+			wantScore: 1.0/2 + 1,
+		},
+		{
+			name:      "namespace overrides",
+			namespace: "training",
+			want:      SustainabilityWeights{TotalCO2Weight: 2, CostWeight: 0, DecayRate: 0.05},
+			wantScore: 2.0 / 2,
+		},
+		{
+			name:        "pod overrides namespace",
+			namespace:   "training",
+			annotations: map[string]string{AnnotationKeyTotalCO2Weight: "4", AnnotationKeyDecayRate: "0.5"},
+			want:        SustainabilityWeights{TotalCO2Weight: 4, CostWeight: 0, DecayRate: 0.5},
+			wantScore:   4.0 / 2,
+		},
+		{
+			name:        "pod overrides zeroing all weights are ignored",
+			namespace:   "default",
+			annotations: map[string]string{AnnotationKeyTotalCO2Weight: "0", AnnotationKeyCostWeight: "0"},
+			want:        config.SustainabilityWeights,
+			wantScore:   1.0/2 + 1,
+		},
+		{
+			name:        "invalid pod overrides are ignored",
+			namespace:   "training",
+			annotations: map[string]string{AnnotationKeyTotalCO2Weight: "4", AnnotationKeyDecayRate: "2"},
+			want:        SustainabilityWeights{TotalCO2Weight: 2, CostWeight: 0, DecayRate: 0.05},
+			wantScore:   2.0 / 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: tt.namespace, Annotations: tt.annotations}}
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(newTestNode("node", "a"))
+
+			state := framework.NewCycleState()
+			if status := gks.PreScore(context.Background(), state, pod, []*framework.NodeInfo{nodeInfo}); !status.IsSuccess() {
+				t.Fatalf("unexpected PreScore status: %v", status)
+			}
+			s, err := getPreScoreState(state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.weights != tt.want {
+				t.Errorf("expected weights %+v, got %+v", tt.want, s.weights)
+			}
+			if got := s.scores["node"]; got != tt.wantScore {
+				t.Errorf("expected score %v, got %v", tt.wantScore, got)
+			}
+		})
+	}
+}
+
+func TestNodeMetricsDeleted(t *testing.T) {
+	metrics.Register()
+	config := Config{SustainabilityWeights: SustainabilityWeights{TotalCO2Weight: 1}}
+	gks := newTestPlugin(t, config, map[string]string{"metrics-node": "a"}, map[string]float64{"a": 1})
+
+	node := newTestNode("metrics-node", "a")
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(node)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+	if status := gks.PreScore(context.Background(), framework.NewCycleState(), pod, []*framework.NodeInfo{nodeInfo}); !status.IsSuccess() {
+		t.Fatalf("unexpected PreScore status: %v", status)
+	}
+	before := promtestutil.CollectAndCount(metrics.NodeCO2)
+
+	// Deleted nodes, including those only known from a tombstone, stop being reported.
+	deleteNodeMetrics(cache.DeletedFinalStateUnknown{Key: node.Name, Obj: node})
+	if after := promtestutil.CollectAndCount(metrics.NodeCO2); after != before-1 {
+		t.Errorf("expected the CO2 series of the deleted node to be removed, got %d series before and %d after", before, after)
+	}
+}
+
+func TestNormalizeScores(t *testing.T) {
+	strategies := []config.ScoreNormalizationType{
+		config.MaxRatioScoreNormalization,
+		config.MinMaxScoreNormalization,
+		config.RankScoreNormalization,
+		config.ZScoreScoreNormalization,
+	}
+
+	tests := []struct {
+		name   string
+		scores []int64
+		want   map[config.ScoreNormalizationType][]int64
+	}{
+		{
+			name:   "empty",
+			scores: []int64{},
+		},
+		{
+			name:   "all zero",
+			scores: []int64{0, 0, 0},
+		},
+		{
+			name:   "single node",
+			scores: []int64{400},
+		},
+		{
+			name:   "equal scores",
+			scores: []int64{400, 400},
+		},
+		{
+			name:   "distinct scores",
+			scores: []int64{500, 1000, 600, 600},
+			want: map[config.ScoreNormalizationType][]int64{
+				config.MaxRatioScoreNormalization: {50, 100, 60, 60},
+				config.MinMaxScoreNormalization:   {0, 100, 20, 20},
+				config.RankScoreNormalization:     {0, 100, 50, 50},
+				// The mean is 675 and the standard deviation 192.
+				config.ZScoreScoreNormalization: {27, 92, 40, 40},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, strategy := range strategies {
+			t.Run(tt.name+"/"+string(strategy), func(t *testing.T) {
+				scores := make(framework.NodeScoreList, len(tt.scores))
+				for i, score := range tt.scores {
+					scores[i] = framework.NodeScore{Name: fmt.Sprintf("node-%d", i), Score: score}
+				}
+				normalizeScores(strategy, scores)
+
+				want := tt.want[strategy]
+				if want == nil {
+					// Equal scores are all normalized alike.
+					want = make([]int64, len(tt.scores))
+					for i, score := range tt.scores {
+						if score > 0 {
+							want[i] = framework.MaxNodeScore
+						}
+					}
+				}
+				for i := range scores {
+					if scores[i].Score != want[i] {
+						t.Errorf("expected normalized scores %v, got %v", want, scores)
+						break
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestNormalizeScoreConsolidation(t *testing.T) {
+	podRequesting := func(cpu, memory string) *v1.Pod {
+		return &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}}}}}}
+	}
+	allocatable := v1.ResourceList{v1.ResourceCPU: resource.MustParse("4"), v1.ResourceMemory: resource.MustParse("8Gi")}
+	nodeSerialNums := map[string]string{"green-idle": "a", "green-busy": "b", "dirty-idle": "c"}
+	newNodes := func() []*framework.NodeInfo {
+		var nodes []*framework.NodeInfo
+		for _, name := range []string{"green-idle", "green-busy", "dirty-idle"} {
+			node := newTestNode(name, nodeSerialNums[name])
+			node.Status.Allocatable = allocatable
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(node)
+			if name == "green-busy" {
+				nodeInfo.AddPod(podRequesting("2", "4Gi"))
+			}
+			nodes = append(nodes, nodeInfo)
+		}
+		return nodes
+	}
+
+	tests := []struct {
+		name          string
+		packingWeight float64
+		want          map[string]int64
+	}{
+		{
+			name: "sustainability only",
+			want: map[string]int64{"green-idle": 100, "green-busy": 100, "dirty-idle": 50},
+		},
+		{
+			// Once the pod is placed, 19% of green-idle and dirty-idle are requested, and 69% of green-busy.
+			name:          "balanced",
+			packingWeight: 0.5,
+			want:          map[string]int64{"green-idle": 59, "green-busy": 84, "dirty-idle": 34},
+		},
+		{
+			name:          "bin-packing only",
+			packingWeight: 1,
+			want:          map[string]int64{"green-idle": 19, "green-busy": 69, "dirty-idle": 19},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{
+				SustainabilityWeights: SustainabilityWeights{TotalCO2Weight: 1},
+				ConsolidationConfig:   ConsolidationConfig{PackingWeight: tt.packingWeight},
+			}
+			gks := newTestPlugin(t, config, nodeSerialNums, map[string]float64{"a": 1, "b": 1, "c": 3})
+			pod := podRequesting("1", "1Gi")
+			nodes := newNodes()
+			state := framework.NewCycleState()
+			if status := gks.PreScore(context.Background(), state, pod, nodes); !status.IsSuccess() {
+				t.Fatalf("unexpected PreScore status: %v", status)
+			}
+			var scores framework.NodeScoreList
+			for _, nodeInfo := range nodes {
+				score, status := gks.Score(context.Background(), state, pod, nodeInfo.Node().Name)
+				if !status.IsSuccess() {
+					t.Fatalf("unexpected Score status: %v", status)
+				}
+				scores = append(scores, framework.NodeScore{Name: nodeInfo.Node().Name, Score: score})
+			}
+			if status := gks.NormalizeScore(context.Background(), state, pod, scores); !status.IsSuccess() {
+				t.Fatalf("unexpected NormalizeScore status: %v", status)
+			}
+
+			got := map[string]int64{}
+			for _, score := range scores {
+				got[score.Name] = score.Score
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected scores %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetNodeKeyLocationFallback(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for name, labels := range map[string]map[string]string{
+		"labelled":  {testSerialNumLabel: "SN1", v1.LabelTopologyZone: "eu-central-1a"},
+		"zone":      {v1.LabelTopologyZone: "eu-central-1a", v1.LabelTopologyRegion: "eu-central-1"},
+		"unmapped":  {v1.LabelTopologyZone: "Hamburg"},
+		"region":    {v1.LabelTopologyRegion: "eu-central-1"},
+		"unlabeled": {},
+	} {
+		if err := indexer.Add(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gks := &GreenScheduling{
+		config: Config{
+			SerialNumLabel: testSerialNumLabel,
+			LocationFallback: carbonprovider.LocationFallback{
+				ZoneFilterKey:   sicparams.FilterKeyLocationCity,
+				RegionFilterKey: sicparams.FilterKeyLocationCountry,
+				Locations:       map[string]string{"eu-central-1a": "Frankfurt", "eu-central-1": "Germany"},
+			},
+		},
+		kubeClient: kubeinfo.NewKubeClient(corelisters.NewNodeLister(indexer)),
+	}
+
+	tests := []struct {
+		node    string
+		want    string
+		wantErr error
+	}{
+		{node: "labelled", want: "SN1"},
+		{node: "zone", want: "location/locationCity=Frankfurt"},
+		{node: "unmapped", want: "location/locationCity=Hamburg"},
+		{node: "region", want: "location/locationCountry=Germany"},
+		{node: "unlabeled", wantErr: kubeinfo.ErrLabelNotFound},
+		{node: "missing", wantErr: kubeinfo.ErrNodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.node, func(t *testing.T) {
+			key, err := gks.getNodeKey(tt.node)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if key != tt.want {
+				t.Errorf("expected key %q, got %q", tt.want, key)
+			}
+			if location, ok := carbonprovider.ParseLocationKey(key); ok && location.Key() != key {
+				t.Errorf("expected location key %q to round-trip, got %q", key, location.Key())
+			}
+		})
+	}
+}
+
+// fakeHandle provides the plugin with a fake clientset.
+type fakeHandle struct {
+	framework.Handle
+	clientSet kubernetes.Interface
+}
+
+func (h *fakeHandle) ClientSet() kubernetes.Interface { return h.clientSet }
+
+func TestPostBindScoreBreakdown(t *testing.T) {
+	config := Config{
+		SustainabilityWeights: SustainabilityWeights{TotalCO2Weight: 1, CostWeight: 1, DecayRate: 0.05},
+		ScoreBreakdown:        config.AlwaysScoreBreakdown,
+	}
+	nodeSerialNums := map[string]string{"node-a": "a", "node-b": "b", "node-c": "c"}
+	gks := newTestPlugin(t, config, nodeSerialNums, map[string]float64{"a": 1, "b": 3, "c": 0})
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+	clientSet := fake.NewSimpleClientset(pod)
+	gks.handle = &fakeHandle{clientSet: clientSet}
+
+	var nodes []*framework.NodeInfo
+	for _, name := range []string{"node-a", "node-b", "node-c"} {
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(newTestNode(name, nodeSerialNums[name]))
+		nodes = append(nodes, nodeInfo)
+	}
+	state := framework.NewCycleState()
+	if status := gks.PreScore(context.Background(), state, pod, nodes); !status.IsSuccess() {
+		t.Fatalf("unexpected PreScore status: %v", status)
+	}
+	gks.PostBind(context.Background(), state, pod, "node-a")
+
+	got, err := clientSet.CoreV1().Pods("default").Get(context.Background(), "pod", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var explanation ScoreExplanation
+	if err := json.Unmarshal([]byte(got.Annotations[AnnotationKeyScoreBreakdown]), &explanation); err != nil {
+		t.Fatalf("expected a score breakdown annotation, got %q: %v", got.Annotations[AnnotationKeyScoreBreakdown], err)
+	}
+
+	want := ScoreExplanation{
+		Selected: NodeScoreBreakdown{Node: "node-a", Score: 1.5, Components: &sustainabilityprofile.ScoreBreakdown{TotalCO2: 0.5, Cost: 1}},
+		RunnerUp: &NodeScoreBreakdown{Node: "node-c", Score: 2, Components: &sustainabilityprofile.ScoreBreakdown{TotalCO2: 1, Cost: 1}},
+	}
+	if !reflect.DeepEqual(explanation, want) {
+		t.Errorf("expected score breakdown %+v, got %+v", want, explanation)
+	}
+	if explanation.Selected.Components.Total() != explanation.Selected.Score {
+		t.Errorf("expected the components to add up to the score %v, got %v", explanation.Selected.Score, explanation.Selected.Components.Total())
+	}
+}
+
+func TestShouldExplainScore(t *testing.T) {
+	tests := []struct {
+		name           string
+		scoreBreakdown config.ScoreBreakdownType
+		annotations    map[string]string
+		want           bool
+	}{
+		{
+			name:           "disabled",
+			scoreBreakdown: config.DisabledScoreBreakdown,
+			annotations:    map[string]string{AnnotationKeyExplainScore: "true"},
+		},
+		{
+			name:           "opt-in requested",
+			scoreBreakdown: config.OptInScoreBreakdown,
+			annotations:    map[string]string{AnnotationKeyExplainScore: "true"},
+			want:           true,
+		},
+		{
+			name:           "opt-in not requested",
+			scoreBreakdown: config.OptInScoreBreakdown,
+		},
+		{
+			name:           "opt-in invalid annotation",
+			scoreBreakdown: config.OptInScoreBreakdown,
+			annotations:    map[string]string{AnnotationKeyExplainScore: "yes please"},
+		},
+		{
+			name:           "always",
+			scoreBreakdown: config.AlwaysScoreBreakdown,
+			want:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gks := &GreenScheduling{config: Config{ScoreBreakdown: tt.scoreBreakdown}}
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Annotations: tt.annotations}}
+			if got := gks.shouldExplainScore(pod); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,46 +1,34 @@
 package kubeinfo
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
+	corelisters "k8s.io/client-go/listers/core/v1"
 )
 
 // Predefined errors for specific cases
 var (
-	ErrNodeNotFound   = errors.New("node not found")
-	ErrLabelNotFound  = errors.New("label not found on node")
-	ErrClientCreation = errors.New("failed to create Kubernetes client")
+	ErrNodeNotFound  = errors.New("node not found")
+	ErrLabelNotFound = errors.New("label not found on node")
 )
 
-// KubeClient wraps a Kubernetes client to interact with the cluster.
+// KubeClient reads node information from the scheduler's shared informer cache,
+// so that lookups are pure in-memory reads and put no load on the API server.
 type KubeClient struct {
-	clientset *kubernetes.Clientset
+	nodeLister corelisters.NodeLister
 }
 
-// NewKubeClient creates a new KubeClient using in-cluster configuration.
-func NewKubeClient() (*KubeClient, error) {
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrClientCreation, err)
-	}
-
-	clientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrClientCreation, err)
-	}
-
-	return &KubeClient{clientset: clientset}, nil
+// NewKubeClient creates a new KubeClient backed by the given node lister,
+// typically handle.SharedInformerFactory().Core().V1().Nodes().Lister().
+func NewKubeClient(nodeLister corelisters.NodeLister) *KubeClient {
+	return &KubeClient{nodeLister: nodeLister}
 }
 
 // GetNodeLabels retrieves the labels of a specified node by node name.
 func (kc *KubeClient) GetNodeLabels(nodeName string) (map[string]string, error) {
-	node, err := kc.clientset.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	node, err := kc.nodeLister.Get(nodeName)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, ErrNodeNotFound
@@ -53,16 +41,12 @@ func (kc *KubeClient) GetNodeLabels(nodeName string) (map[string]string, error)
 
 // GetNodeLabelValue retrieves the value of a specific label for a given node.
 func (kc *KubeClient) GetNodeLabelValue(nodeName, label string) (string, error) {
-	node, err := kc.clientset.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	labels, err := kc.GetNodeLabels(nodeName)
 	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			return "", ErrNodeNotFound
-		}
-
-		return "", fmt.Errorf("failed to get node %s: %w", nodeName, err)
+		return "", err
 	}
 
-	value, exists := node.Labels[label]
+	value, exists := labels[label]
 	if !exists {
 		return "", ErrLabelNotFound
 	}
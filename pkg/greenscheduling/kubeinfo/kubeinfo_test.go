@@ -0,0 +1,63 @@
+package kubeinfo
+
+import (
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestGetNodeLabelValue(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-a",
+			Labels: map[string]string{"serial": "SN1"},
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kc := NewKubeClient(corelisters.NewNodeLister(indexer))
+
+	tests := []struct {
+		name     string
+		nodeName string
+		label    string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "label present",
+			nodeName: "node-a",
+			label:    "serial",
+			want:     "SN1",
+		},
+		{
+			name:     "label missing",
+			nodeName: "node-a",
+			label:    "other",
+			wantErr:  ErrLabelNotFound,
+		},
+		{
+			name:     "node missing",
+			nodeName: "node-b",
+			label:    "serial",
+			wantErr:  ErrNodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kc.GetNodeLabelValue(tt.nodeName, tt.label)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetNodeLabelValue() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetNodeLabelValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
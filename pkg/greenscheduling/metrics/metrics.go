@@ -0,0 +1,147 @@
+// Package metrics defines the Prometheus metrics exposed by the GreenScheduling plugin.
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	// GreenSchedulingSubsystem is the subsystem name used by the GreenScheduling plugin metrics.
+	GreenSchedulingSubsystem = "green_scheduling"
+)
+
+var (
+	// SICRequestDuration is the latency of SIC API request attempts, by endpoint and status code.
+	SICRequestDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "sic_request_duration_seconds",
+			Help:           "Latency of SIC API request attempts in seconds, by endpoint and status code.",
+			Buckets:        metrics.ExponentialBuckets(0.01, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"endpoint", "code"})
+
+	// SICRequestErrors is the number of failed SIC API request attempts, by endpoint and status code.
+	// The code is "error" for attempts failing without a response.
+	SICRequestErrors = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "sic_request_errors_total",
+			Help:           "Number of failed SIC API request attempts, by endpoint and status code.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"endpoint", "code"})
+
+	// TokenFetchDuration is the latency of SIC access token requests, by grant type.
+	TokenFetchDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "sic_token_fetch_duration_seconds",
+			Help:           "Latency of SIC access token requests in seconds, by grant type.",
+			Buckets:        metrics.ExponentialBuckets(0.01, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"grant_type"})
+
+	// TokenFetchFailures is the number of failed SIC access token requests, by grant type.
+	TokenFetchFailures = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "sic_token_fetch_failures_total",
+			Help:           "Number of failed SIC access token requests, by grant type.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"grant_type"})
+
+	// TokenRefreshes is the number of SIC access tokens obtained, by grant type.
+	TokenRefreshes = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "sic_token_refreshes_total",
+			Help:           "Number of SIC access tokens obtained, by grant type.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"grant_type"})
+
+	// ScoreCacheLookups is the number of score cache lookups in PreScore, by result: "hit" for
+	// fresh entries, "stale" for last known entries used while the provider is unavailable,
+	// and "miss" otherwise. The hit ratio is hit / (hit + stale + miss).
+	ScoreCacheLookups = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "score_cache_lookups_total",
+			Help:           "Number of sustainability score cache lookups, by result.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"result"})
+
+	// NodeSustainabilityScore is the latest raw sustainability score of a node.
+	NodeSustainabilityScore = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "node_sustainability_score",
+			Help:           "Latest raw sustainability score of a node.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"node"})
+
+	// NodeCO2 is the total CO2 emissions of a node over the consideration window.
+	NodeCO2 = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "node_co2_tonnes",
+			Help:           "Total CO2 emissions of a node in metric tons over the consideration window.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"node"})
+
+	// NodeCost is the total cost of a node over the consideration window.
+	NodeCost = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "node_cost",
+			Help:           "Total cost of a node over the consideration window.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"node"})
+
+	// ScheduledNodeScoreRatio is the raw sustainability score of the node a pod was bound to,
+	// relative to the best score among the nodes it was scored against. A ratio of 1 means
+	// the pod landed on the most sustainable node.
+	ScheduledNodeScoreRatio = metrics.NewHistogram(
+		&metrics.HistogramOpts{
+			Subsystem:      GreenSchedulingSubsystem,
+			Name:           "scheduled_node_score_ratio",
+			Help:           "Sustainability score of the node a pod was bound to, relative to the best scored node.",
+			Buckets:        metrics.LinearBuckets(0.1, 0.1, 10),
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	metricsList = []metrics.Registerable{
+		SICRequestDuration,
+		SICRequestErrors,
+		TokenFetchDuration,
+		TokenFetchFailures,
+		TokenRefreshes,
+		ScoreCacheLookups,
+		NodeSustainabilityScore,
+		NodeCO2,
+		NodeCost,
+		ScheduledNodeScoreRatio,
+	}
+)
+
+var registerMetrics sync.Once
+
+// Register all GreenScheduling metrics with the legacy registry.
+func Register() {
+	registerMetrics.Do(func() {
+		for _, metric := range metricsList {
+			legacyregistry.MustRegister(metric)
+		}
+	})
+}
+
+// DeleteNode stops reporting the per-node metrics of a node, e.g. once it has been deleted or no
+// longer has carbon data, so that they neither report stale values nor accumulate.
+func DeleteNode(node string) {
+	labels := map[string]string{"node": node}
+	NodeSustainabilityScore.Delete(labels)
+	NodeCO2.Delete(labels)
+	NodeCost.Delete(labels)
+}
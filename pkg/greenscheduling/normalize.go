@@ -0,0 +1,117 @@
+package greenscheduling
+
+import (
+	"math"
+	"sort"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// zScoreClamp is the number of standard deviations from the mean mapped to the bounds of the
+// framework's score range by the ZScore normalization; more distant scores are clamped.
+const zScoreClamp = 2.0
+
+// normalizeScores scales the raw scores in place to [0, framework.MaxNodeScore] with the given
+// strategy. Higher raw scores always map to higher or equal normalized scores. Lists whose scores
+// are all equal, including empty, single-node and all-zero lists, get framework.MaxNodeScore for
+// positive scores and 0 otherwise, so that there is never a division by zero.
+func normalizeScores(strategy config.ScoreNormalizationType, scores framework.NodeScoreList) {
+	if len(scores) == 0 {
+		return
+	}
+	minScore, maxScore := scores[0].Score, scores[0].Score
+	for _, node := range scores {
+		minScore = min(minScore, node.Score)
+		maxScore = max(maxScore, node.Score)
+	}
+	if minScore == maxScore {
+		uniform := int64(0)
+		if maxScore > 0 {
+			uniform = framework.MaxNodeScore
+		}
+		for i := range scores {
+			scores[i].Score = uniform
+		}
+		return
+	}
+
+	switch strategy {
+	case config.MinMaxScoreNormalization:
+		normalizeMinMax(scores, minScore, maxScore)
+	case config.RankScoreNormalization:
+		normalizeRank(scores)
+	case config.ZScoreScoreNormalization:
+		normalizeZScore(scores)
+	default:
+		normalizeMaxRatio(scores, maxScore)
+	}
+}
+
+// normalizeMaxRatio scales scores relative to the highest one. Since raw scores are never negative,
+// the lowest scored node may still get a high score if the raw scores are close.
+func normalizeMaxRatio(scores framework.NodeScoreList, maxScore int64) {
+	if maxScore <= 0 {
+		for i := range scores {
+			scores[i].Score = 0
+		}
+		return
+	}
+	for i, node := range scores {
+		scores[i].Score = max(node.Score, 0) * framework.MaxNodeScore / maxScore
+	}
+}
+
+// normalizeMinMax scales scores linearly so that the lowest scored node gets 0 and the highest
+// scored node gets framework.MaxNodeScore.
+func normalizeMinMax(scores framework.NodeScoreList, minScore, maxScore int64) {
+	for i, node := range scores {
+		scores[i].Score = (node.Score - minScore) * framework.MaxNodeScore / (maxScore - minScore)
+	}
+}
+
+// normalizeRank spreads scores evenly by their rank among the distinct raw scores, so that outliers
+// do not compress the other nodes. Nodes with equal raw scores share a rank.
+func normalizeRank(scores framework.NodeScoreList) {
+	distinct := make([]int64, 0, len(scores))
+	seen := make(map[int64]bool, len(scores))
+	for _, node := range scores {
+		if !seen[node.Score] {
+			seen[node.Score] = true
+			distinct = append(distinct, node.Score)
+		}
+	}
+	sort.Slice(distinct, func(i, j int) bool { return distinct[i] < distinct[j] })
+
+	ranks := make(map[int64]int64, len(distinct))
+	for rank, score := range distinct {
+		ranks[score] = int64(rank)
+	}
+	highestRank := int64(len(distinct) - 1)
+	for i, node := range scores {
+		scores[i].Score = ranks[node.Score] * framework.MaxNodeScore / highestRank
+	}
+}
+
+// normalizeZScore maps the z-score of every raw score, clamped to ±zScoreClamp, linearly to the
+// framework's score range, so that the mean raw score maps to the middle of the range.
+func normalizeZScore(scores framework.NodeScoreList) {
+	n := float64(len(scores))
+	var mean float64
+	for _, node := range scores {
+		mean += float64(node.Score)
+	}
+	mean /= n
+
+	var variance float64
+	for _, node := range scores {
+		variance += math.Pow(float64(node.Score)-mean, 2)
+	}
+	stdDev := math.Sqrt(variance / n)
+
+	for i, node := range scores {
+		z := math.Max(-zScoreClamp, math.Min(zScoreClamp, (float64(node.Score)-mean)/stdDev))
+		scores[i].Score = int64(math.Round((z + zScoreClamp) / (2 * zScoreClamp) * float64(framework.MaxNodeScore)))
+	}
+}
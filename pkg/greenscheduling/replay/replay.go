@@ -0,0 +1,229 @@
+// Package replay scores recorded carbon data offline, with the same profile, scoring and
+// normalization code as the GreenScheduling plugin, so that candidate configurations, e.g. of
+// the weights and the decay rate, can be compared without deploying the scheduler.
+package replay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	configscheme "sigs.k8s.io/scheduler-plugins/apis/config/scheme"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+)
+
+// Recording holds recorded carbon data keyed by serial number. It provides the data regardless of
+// the requested time range, as recordings lie in the past of the consideration window.
+type Recording map[string]carbonprovider.CarbonData
+
+var _ carbonprovider.CarbonDataProvider = Recording{}
+
+// GetCarbonData returns the recorded carbon data of the given keys.
+func (r Recording) GetCarbonData(_ context.Context, keys []string, _, _ time.Time) (map[string]carbonprovider.CarbonData, error) {
+	data := make(map[string]carbonprovider.CarbonData, len(keys))
+	for _, key := range keys {
+		if d, ok := r[key]; ok {
+			data[key] = d
+		}
+	}
+	return data, nil
+}
+
+// LoadRecording reads a recorded SIC usage by entity response, and the usage series responses of
+// its serial numbers stored in seriesDir as <serial number>.json. Serial numbers without a usage
+// series file have no emission data points. As with the SIC provider, the usage of the entities
+// of a serial number is aggregated as configured.
+func LoadRecording(usageByEntityPath, seriesDir string, aggregation config.EntityAggregationType) (Recording, error) {
+	var usageByEntity sicresponse.UsageByEntityResponse
+	if err := readFile(usageByEntityPath, &usageByEntity); err != nil {
+		return nil, err
+	}
+
+	var serialNums []string
+	entities := make(map[string][]sicresponse.UsageEntity, len(usageByEntity.Items))
+	for _, entity := range usageByEntity.Items {
+		if _, ok := entities[entity.EntitySerialNum]; !ok {
+			serialNums = append(serialNums, entity.EntitySerialNum)
+		}
+		entities[entity.EntitySerialNum] = append(entities[entity.EntitySerialNum], entity)
+	}
+
+	recording := make(Recording, len(serialNums))
+	for _, serialNum := range serialNums {
+		usageSeries := &sicresponse.UsageSeriesResponse{}
+		if seriesDir != "" {
+			path := filepath.Join(seriesDir, serialNum+".json")
+			if err := readFile(path, usageSeries); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+		data, err := carbonprovider.NewCarbonDataFromSIC(entities[serialNum], usageSeries, aggregation)
+		if err != nil {
+			return nil, fmt.Errorf("error building emission data points for serial number %s: %w", serialNum, err)
+		}
+		recording[serialNum] = data
+	}
+	return recording, nil
+}
+
+// LoadNodes reads the nodes of a JSON or YAML NodeList, e.g. the output of `kubectl get nodes -o yaml`.
+func LoadNodes(path string) ([]v1.Node, error) {
+	var nodes v1.NodeList
+	if err := readFile(path, &nodes); err != nil {
+		return nil, err
+	}
+	return nodes.Items, nil
+}
+
+// Candidate is a configuration of the plugin to score the recording with.
+type Candidate struct {
+	Name string
+	Args *config.GreenSchedulingArgs
+}
+
+// LoadCandidate reads a GreenSchedulingArgs file, applying the defaults of the plugin, and names
+// the candidate after the file. Only the args scores are calculated with are validated, so the
+// file does not need to configure a carbon data provider.
+func LoadCandidate(path string) (Candidate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Candidate{}, err
+	}
+	obj, _, err := configscheme.Codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		return Candidate{}, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	args, ok := obj.(*config.GreenSchedulingArgs)
+	if !ok {
+		return Candidate{}, fmt.Errorf("expected GreenSchedulingArgs in %s but got %T", path, obj)
+	}
+	if errs := validation.ValidateGreenSchedulingScoringArgs(nil, args); len(errs) > 0 {
+		return Candidate{}, fmt.Errorf("validation failed for GreenSchedulingArgs in %s: %w", path, errs.ToAggregate())
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return Candidate{Name: name, Args: args}, nil
+}
+
+// NodeResult holds the score of a node under a candidate configuration.
+type NodeResult struct {
+	// Key is the serial number, or location, the node is matched with, empty if none.
+	Key string
+	// RawScore is the sustainability score before normalization, 0 without carbon data.
+	RawScore float64
+	// Score is the normalized score reported to the framework.
+	Score int64
+	// Rank is the position of the node by normalized score, starting at 1. Nodes with equal
+	// scores share a rank.
+	Rank int
+}
+
+// Result holds the scores of all nodes, keyed by node name, under a candidate configuration.
+type Result struct {
+	Candidate string
+	Nodes     map[string]NodeResult
+}
+
+// Replay scores the nodes with the recorded carbon data under every candidate configuration.
+// Nodes falling back to a location are not matched with any recorded data and score 0, like
+// nodes whose serial number is not in the recording.
+func Replay(ctx context.Context, candidates []Candidate, nodes []v1.Node, recording Recording) ([]Result, error) {
+	results := make([]Result, 0, len(candidates))
+	for _, candidate := range candidates {
+		scorer := greenscheduling.NewScorer(candidate.Args, recording)
+
+		nodeKeys := make(map[string]string, len(nodes))
+		keys := sets.New[string]()
+		for _, node := range nodes {
+			if key, ok := scorer.NodeKey(node.Labels); ok {
+				nodeKeys[node.Name] = key
+				keys.Insert(key)
+			}
+		}
+		entries, err := scorer.Fetch(ctx, sets.List(keys))
+		if err != nil {
+			return nil, fmt.Errorf("error scoring candidate %s: %w", candidate.Name, err)
+		}
+
+		rawScores := make(map[string]float64, len(nodes))
+		for _, node := range nodes {
+			rawScores[node.Name] = entries[nodeKeys[node.Name]].Score
+		}
+		scores := scorer.Normalize(rawScores)
+		sort.SliceStable(scores, func(i, j int) bool { return scores[i].Score > scores[j].Score })
+
+		result := Result{Candidate: candidate.Name, Nodes: make(map[string]NodeResult, len(nodes))}
+		for i, score := range scores {
+			rank := i + 1
+			if i > 0 && score.Score == scores[i-1].Score {
+				rank = result.Nodes[scores[i-1].Name].Rank
+			}
+			result.Nodes[score.Name] = NodeResult{
+				Key:      nodeKeys[score.Name],
+				RawScore: rawScores[score.Name],
+				Score:    score.Score,
+				Rank:     rank,
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Print writes the results as a table with a column per candidate, holding the rank, normalized
+// score and raw score of every node. Nodes are ordered by their rank under the first candidate.
+func Print(w io.Writer, nodes []v1.Node, results []Result) error {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Name
+	}
+	sort.Strings(names)
+	if len(results) > 0 {
+		first := results[0].Nodes
+		sort.SliceStable(names, func(i, j int) bool { return first[names[i]].Rank < first[names[j]].Rank })
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := []string{"NODE", "KEY"}
+	for _, result := range results {
+		header = append(header, strings.ToUpper(result.Candidate))
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, name := range names {
+		row := []string{name, "-"}
+		for i, result := range results {
+			node := result.Nodes[name]
+			if i == 0 && node.Key != "" {
+				row[1] = node.Key
+			}
+			row = append(row, fmt.Sprintf("#%d %d (%.4f)", node.Rank, node.Score, node.RawScore))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// readFile unmarshals a JSON or YAML file.
+func readFile(path string, obj interface{}) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(content, obj); err != nil {
+		return fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	dir := t.TempDir()
+	seriesDir := filepath.Join(dir, "series")
+	if err := os.Mkdir(seriesDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// SN1 emits little in total but a lot recently, SN2 the other way round.
+	writeFile(t, filepath.Join(dir, "usage.json"), `{"items": [
+		{"entitySerialNum": "SN1", "co2eMetricTon": 1, "costUsd": 10, "kwh": 100},
+		{"entitySerialNum": "SN2", "co2eMetricTon": 3, "costUsd": 10, "kwh": 100}
+	], "count": 2, "total": 2}`)
+	writeFile(t, filepath.Join(seriesDir, "SN1.json"), `{"items": [
+		{"timeBucket": "2024-01-01T00:00:00Z", "co2eMetricTon": 0.1},
+		{"timeBucket": "2024-01-01T01:00:00Z", "co2eMetricTon": 0.9}
+	], "count": 2}`)
+	writeFile(t, filepath.Join(seriesDir, "SN2.json"), `{"items": [
+		{"timeBucket": "2024-01-01T00:00:00Z", "co2eMetricTon": 2.9},
+		{"timeBucket": "2024-01-01T01:00:00Z", "co2eMetricTon": 0.1}
+	], "count": 2}`)
+	writeFile(t, filepath.Join(dir, "nodes.yaml"), `apiVersion: v1
+kind: NodeList
+items:
+- metadata:
+    name: node-1
+    labels:
+      serial-number: SN1
+- metadata:
+    name: node-2
+    labels:
+      serial-number: SN2
+- metadata:
+    name: node-3
+`)
+	writeFile(t, filepath.Join(dir, "total.yaml"), `apiVersion: kubescheduler.config.k8s.io/v1
+kind: GreenSchedulingArgs
+serialNumLabel: serial-number
+co2DecayWeight: 0
+totalCO2Weight: 1
+costWeight: 0
+`)
+	writeFile(t, filepath.Join(dir, "decay.yaml"), `apiVersion: kubescheduler.config.k8s.io/v1
+kind: GreenSchedulingArgs
+serialNumLabel: serial-number
+co2DecayWeight: 1
+totalCO2Weight: 0
+costWeight: 0
+decayModel: EWMA
+decayHalfLifeHours: 0.1
+`)
+
+	nodes, err := LoadNodes(filepath.Join(dir, "nodes.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recording, err := LoadRecording(filepath.Join(dir, "usage.json"), seriesDir, config.SumEntityAggregation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recording["SN1"].Emissions) != 2 || recording["SN1"].TotalCo2 != 1 {
+		t.Fatalf("expected SN1 to be recorded with 2 emission data points, got %+v", recording["SN1"])
+	}
+	var candidates []Candidate
+	for _, name := range []string{"total.yaml", "decay.yaml"} {
+		candidate, err := LoadCandidate(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	results, err := Replay(context.Background(), candidates, nodes, recording)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRanks := map[string]map[string]int{
+		"total": {"node-1": 1, "node-2": 2, "node-3": 3},
+		"decay": {"node-2": 1, "node-1": 2, "node-3": 3},
+	}
+	for _, result := range results {
+		for node, want := range wantRanks[result.Candidate] {
+			if got := result.Nodes[node].Rank; got != want {
+				t.Errorf("expected %s to rank %d under %s, got %d (%+v)", node, want, result.Candidate, got, result.Nodes)
+			}
+		}
+	}
+	if node := results[0].Nodes["node-3"]; node.Key != "" || node.RawScore != 0 || node.Score != 0 {
+		t.Errorf("expected the unlabelled node to score 0, got %+v", node)
+	}
+
+	var out bytes.Buffer
+	if err := Print(&out, nodes, results); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || !strings.Contains(lines[0], "TOTAL") || !strings.Contains(lines[0], "DECAY") || !strings.HasPrefix(lines[1], "node-1") {
+		t.Errorf("unexpected table:\n%s", out.String())
+	}
+}
@@ -0,0 +1,181 @@
+// Package scorecache provides a node-level cache of sustainability profiles and scores,
+// keyed by the node serial number and kept up to date by a background refresher.
+package scorecache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sustainabilityprofile"
+)
+
+// Entry holds the cached sustainability data of a single serial number.
+type Entry struct {
+	Profile   sustainabilityprofile.SustainabilityProfile // Profile the score was calculated from
+	Score     float64                                     // Raw sustainability score
+	UpdatedAt time.Time                                   // Time the entry was last refreshed
+}
+
+// Age returns how long ago the entry was refreshed.
+func (e Entry) Age(now time.Time) time.Duration {
+	return now.Sub(e.UpdatedAt)
+}
+
+// RefreshFunc fetches fresh entries for the given serial numbers. Serial numbers
+// missing from the returned map are considered unknown to the data source.
+type RefreshFunc func(ctx context.Context, serialNums []string) (map[string]Entry, error)
+
+// Cache is a concurrency-safe cache of sustainability entries keyed by serial number.
+// Entries older than the TTL are treated as missing. Serial numbers are tracked once
+// they are requested and periodically refreshed in the background, so that readers
+// never have to wait on the data source, until they have not been requested for a TTL,
+// e.g. because their node was deleted. While the data source is unavailable, expired
+// entries are kept so that readers can fall back to the last known data.
+type Cache struct {
+	ttl           time.Duration
+	refreshPeriod time.Duration
+	refreshFunc   RefreshFunc
+
+	mu      sync.RWMutex
+	entries map[string]Entry
+	// tracked holds the time every tracked serial number was last requested.
+	tracked map[string]time.Time
+
+	// healthy is false while the latest refresh failed.
+	healthy bool
+
+	// pending is signalled when new serial numbers are tracked, to trigger
+	// an out-of-band refresh without waiting for the next period.
+	pending chan struct{}
+
+	// now allows tests to control the clock.
+	now func() time.Time
+}
+
+// New creates a new Cache. Call Run to start the background refresher.
+func New(ttl, refreshPeriod time.Duration, refreshFunc RefreshFunc) *Cache {
+	return &Cache{
+		ttl:           ttl,
+		refreshPeriod: refreshPeriod,
+		refreshFunc:   refreshFunc,
+		entries:       make(map[string]Entry),
+		tracked:       make(map[string]time.Time),
+		pending:       make(chan struct{}, 1),
+		healthy:       true,
+		now:           time.Now,
+	}
+}
+
+// Get returns the entry of the given serial number, if present and not expired.
+func (c *Cache) Get(serialNum string) (Entry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[serialNum]
+	if !ok || entry.Age(c.now()) > c.ttl {
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// GetLastKnown returns the entry of the given serial number, if present, regardless of its age.
+func (c *Cache) GetLastKnown(serialNum string) (Entry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[serialNum]
+	return entry, ok
+}
+
+// Healthy returns false if the latest refresh failed, i.e. the data source is unavailable.
+func (c *Cache) Healthy() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.healthy
+}
+
+// Track registers serial numbers as requested, to be kept up to date by the background
+// refresher. Serial numbers that were not tracked yet trigger an immediate asynchronous refresh.
+func (c *Cache) Track(serialNums ...string) {
+	c.mu.Lock()
+	added := false
+	now := c.now()
+	for _, serialNum := range serialNums {
+		if _, ok := c.tracked[serialNum]; !ok {
+			added = true
+		}
+		c.tracked[serialNum] = now
+	}
+	c.mu.Unlock()
+
+	if added {
+		select {
+		case c.pending <- struct{}{}:
+		default:
+			// A refresh is already pending.
+		}
+	}
+}
+
+// Run refreshes all tracked serial numbers every refresh period, and whenever new
+// serial numbers are tracked, until the context is done.
+func (c *Cache) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.refreshPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Refresh(ctx)
+		case <-c.pending:
+			c.Refresh(ctx)
+		}
+	}
+}
+
+// Refresh stops tracking the serial numbers not requested within the TTL, fetches fresh entries for
+// the remaining ones and, if successful, drops expired entries and those no longer tracked.
+func (c *Cache) Refresh(ctx context.Context) {
+	c.mu.Lock()
+	now := c.now()
+	for serialNum, requestedAt := range c.tracked {
+		if now.Sub(requestedAt) > c.ttl {
+			delete(c.tracked, serialNum)
+		}
+	}
+	serialNums := sets.List(sets.KeySet(c.tracked))
+	c.mu.Unlock()
+
+	if len(serialNums) == 0 {
+		return
+	}
+
+	entries, err := c.refreshFunc(ctx, serialNums)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.healthy = err == nil
+	if err != nil {
+		// Keep serving the previous entries until they expire, and keep expired ones
+		// as last known data until the data source is available again.
+		klog.ErrorS(err, "Failed to refresh sustainability score cache", "serialNums", len(serialNums))
+		return
+	}
+
+	for serialNum, entry := range entries {
+		c.entries[serialNum] = entry
+	}
+	now = c.now()
+	for serialNum, entry := range c.entries {
+		if _, ok := c.tracked[serialNum]; !ok || entry.Age(now) > c.ttl {
+			delete(c.entries, serialNum)
+		}
+	}
+}
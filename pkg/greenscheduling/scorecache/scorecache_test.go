@@ -0,0 +1,135 @@
+package scorecache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCacheRefreshAndExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fail, removed := false, false
+	refreshFunc := func(_ context.Context, serialNums []string) (map[string]Entry, error) {
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		entries := map[string]Entry{}
+		for _, serialNum := range serialNums {
+			if serialNum == "unknown" || removed {
+				continue
+			}
+			entries[serialNum] = Entry{Score: float64(len(serialNum)), UpdatedAt: now}
+		}
+		return entries, nil
+	}
+
+	c := New(time.Hour, time.Minute, refreshFunc)
+	c.now = func() time.Time { return now }
+
+	if _, ok := c.Get("SN1"); ok {
+		t.Fatalf("expected no entry before the first refresh")
+	}
+
+	c.Track("SN1", "unknown")
+	c.Refresh(context.Background())
+
+	entry, ok := c.Get("SN1")
+	if !ok || entry.Score != 3 {
+		t.Fatalf("expected entry with score 3, got %+v (found: %v)", entry, ok)
+	}
+	if _, ok := c.Get("unknown"); ok {
+		t.Errorf("expected no entry for a serial number unknown to the data source")
+	}
+
+	// A failed refresh keeps serving the previous entries.
+	fail = true
+	now = now.Add(30 * time.Minute)
+	c.Refresh(context.Background())
+	entry, ok = c.Get("SN1")
+	if !ok {
+		t.Fatalf("expected entry to survive a failed refresh")
+	}
+	if age := entry.Age(now); age != 30*time.Minute {
+		t.Errorf("expected entry age of 30m, got %v", age)
+	}
+
+	// Entries are treated as missing once they outlive the TTL, but remain available
+	// as last known data while the data source is unavailable.
+	now = now.Add(time.Hour)
+	if _, ok := c.Get("SN1"); ok {
+		t.Errorf("expected entry to be expired")
+	}
+	c.Track("SN1")
+	c.Refresh(context.Background())
+	if c.Healthy() {
+		t.Errorf("expected cache to be unhealthy after a failed refresh")
+	}
+	if _, ok := c.GetLastKnown("SN1"); !ok {
+		t.Errorf("expected expired entry to be kept while the data source is unavailable")
+	}
+
+	// Expired entries are evicted by the next successful refresh.
+	fail, removed = false, true
+	c.Refresh(context.Background())
+	if !c.Healthy() {
+		t.Errorf("expected cache to be healthy after a successful refresh")
+	}
+	if len(c.entries) != 0 {
+		t.Errorf("expected expired entries to be evicted, got %d", len(c.entries))
+	}
+}
+
+func TestCachePrunesUnrequestedSerialNumbers(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var refreshed []string
+	c := New(time.Hour, time.Minute, func(_ context.Context, serialNums []string) (map[string]Entry, error) {
+		refreshed = serialNums
+		entries := map[string]Entry{}
+		for _, serialNum := range serialNums {
+			entries[serialNum] = Entry{UpdatedAt: now}
+		}
+		return entries, nil
+	})
+	c.now = func() time.Time { return now }
+
+	c.Track("SN1", "SN2")
+	c.Refresh(context.Background())
+
+	// SN2 is no longer requested, e.g. because its node was deleted.
+	now = now.Add(30 * time.Minute)
+	c.Track("SN1")
+	now = now.Add(45 * time.Minute)
+	c.Refresh(context.Background())
+	if len(refreshed) != 1 || refreshed[0] != "SN1" {
+		t.Errorf("expected only SN1 to be refreshed, got %v", refreshed)
+	}
+	if _, ok := c.GetLastKnown("SN2"); ok {
+		t.Errorf("expected the entry of the untracked serial number to be dropped")
+	}
+	if _, ok := c.Get("SN1"); !ok {
+		t.Errorf("expected the entry of the requested serial number to be kept")
+	}
+}
+
+func TestCacheTrackTriggersRefresh(t *testing.T) {
+	refreshed := make(chan []string, 1)
+	c := New(time.Hour, time.Hour, func(_ context.Context, serialNums []string) (map[string]Entry, error) {
+		refreshed <- serialNums
+		return nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Run(ctx)
+
+	c.Track("SN1")
+	select {
+	case serialNums := <-refreshed:
+		if len(serialNums) != 1 || serialNums[0] != "SN1" {
+			t.Errorf("unexpected serial numbers refreshed: %v", serialNums)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out waiting for refresh of newly tracked serial number")
+	}
+}
@@ -0,0 +1,50 @@
+package greenscheduling
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/carbonprovider"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/scorecache"
+)
+
+// Scorer fetches carbon data and calculates sustainability scores the way the plugin does, for
+// components sharing its arguments outside of the scheduler, such as the NodeSustainability
+// controller.
+type Scorer struct {
+	gks *GreenScheduling
+}
+
+// NewScorer creates a Scorer fetching carbon data from the provider, with the weights, decay
+// model and embodied carbon configuration of the given plugin arguments.
+func NewScorer(args *config.GreenSchedulingArgs, provider carbonprovider.CarbonDataProvider) *Scorer {
+	return &Scorer{gks: &GreenScheduling{config: newConfig(args), provider: provider}}
+}
+
+// NodeKey returns the key of the carbon data of a node with the given labels, or false if the
+// node cannot be matched with any carbon data.
+func (s *Scorer) NodeKey(labels map[string]string) (string, bool) {
+	return carbonprovider.NodeKey(labels, s.gks.config.SerialNumLabel, s.gks.config.LocationFallback)
+}
+
+// Fetch fetches the carbon data of the given keys over the consideration window and returns
+// the sustainability profile and score of every key known to the provider.
+func (s *Scorer) Fetch(ctx context.Context, keys []string) (map[string]scorecache.Entry, error) {
+	return s.gks.fetchCacheEntries(ctx, keys)
+}
+
+// Normalize converts raw sustainability scores, keyed by node name, to the node scores the plugin
+// reports to the framework, scaled and normalized with the configured strategy. Nodes without
+// carbon data are to be included with a raw score of 0. The list is sorted by node name.
+func (s *Scorer) Normalize(rawScores map[string]float64) framework.NodeScoreList {
+	scores := make(framework.NodeScoreList, 0, len(rawScores))
+	for nodeName, score := range rawScores {
+		scores = append(scores, framework.NodeScore{Name: nodeName, Score: int64(score * scoreScalingFactor)})
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].Name < scores[j].Name })
+	normalizeScores(s.gks.config.ScoreNormalization, scores)
+	return scores
+}
@@ -0,0 +1,99 @@
+package sicclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting SIC while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("SIC circuit breaker is open")
+
+// circuitState is the state of a circuitBreaker.
+type circuitState int
+
+const (
+	circuitClosed   circuitState = iota // Requests are allowed
+	circuitOpen                         // Requests are rejected
+	circuitHalfOpen                     // A single probe request is allowed
+)
+
+// circuitBreaker stops requests to SIC after a number of consecutive failures, and lets a
+// single probe request through once the open duration has elapsed. A successful probe closes
+// the breaker again, a failed or abandoned one re-opens it.
+type circuitBreaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+
+	// now allows tests to control the clock.
+	now func() time.Time
+}
+
+// newCircuitBreaker creates a closed circuitBreaker.
+func newCircuitBreaker(failureThreshold int, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		now:              time.Now,
+	}
+}
+
+// Allow returns ErrCircuitOpen if a request must not be sent.
+func (cb *circuitBreaker) Allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.openDuration {
+			return ErrCircuitOpen
+		}
+		cb.state = circuitHalfOpen
+		return nil
+	case circuitHalfOpen:
+		// A probe request is already in flight.
+		return ErrCircuitOpen
+	default:
+		return nil
+	}
+}
+
+// RecordSuccess closes the breaker and resets the failure count.
+func (cb *circuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.state = circuitClosed
+	cb.failures = 0
+}
+
+// RecordFailure counts a failed request, opening the breaker once the failure threshold is
+// reached or when a probe request fails.
+func (cb *circuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.failureThreshold {
+		cb.state = circuitOpen
+		cb.openedAt = cb.now()
+	}
+}
+
+// RecordAbandoned re-opens the breaker if a probe request was abandoned by its caller, so that
+// another probe is let through once the open duration has elapsed again. Abandoned requests
+// while the breaker is closed say nothing about the health of SIC and are not counted.
+func (cb *circuitBreaker) RecordAbandoned() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == circuitHalfOpen {
+		cb.state = circuitOpen
+		cb.openedAt = cb.now()
+	}
+}
@@ -0,0 +1,75 @@
+package sicclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// ClientSecretSource provides the client secret used to generate access tokens. The secret
+// is looked up on every token request, so that rotated secrets are picked up without a restart.
+type ClientSecretSource interface {
+	ClientSecret(ctx context.Context) (string, error)
+}
+
+// StaticClientSecret is a client secret that never changes.
+type StaticClientSecret string
+
+var _ ClientSecretSource = StaticClientSecret("")
+
+// ClientSecret returns the static client secret.
+func (s StaticClientSecret) ClientSecret(_ context.Context) (string, error) {
+	return string(s), nil
+}
+
+// FileClientSecret reads the client secret from a file, such as a mounted Secret, which the
+// kubelet updates in place when the Secret is rotated. Surrounding whitespace is trimmed.
+type FileClientSecret struct {
+	Path string
+}
+
+var _ ClientSecretSource = FileClientSecret{}
+
+// ClientSecret reads the client secret from the file.
+func (s FileClientSecret) ClientSecret(_ context.Context) (string, error) {
+	content, err := os.ReadFile(s.Path)
+	if err != nil {
+		return "", fmt.Errorf("error reading client secret file %s: %w", s.Path, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// SecretClientSecret reads the client secret from a key of a Kubernetes Secret through a lister,
+// so that lookups are served from an informer cache.
+type SecretClientSecret struct {
+	lister corelisters.SecretNamespaceLister
+	name   string
+	key    string
+}
+
+var _ ClientSecretSource = &SecretClientSecret{}
+
+// NewSecretClientSecret creates a SecretClientSecret reading the given key of the named Secret.
+func NewSecretClientSecret(lister corelisters.SecretNamespaceLister, name, key string) *SecretClientSecret {
+	return &SecretClientSecret{
+		lister: lister,
+		name:   name,
+		key:    key,
+	}
+}
+
+// ClientSecret reads the client secret from the Secret.
+func (s *SecretClientSecret) ClientSecret(_ context.Context) (string, error) {
+	secret, err := s.lister.Get(s.name)
+	if err != nil {
+		return "", fmt.Errorf("error getting client secret Secret %s: %w", s.name, err)
+	}
+	value, ok := secret.Data[s.key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in client secret Secret %s", s.key, s.name)
+	}
+	return string(value), nil
+}
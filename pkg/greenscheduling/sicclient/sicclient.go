@@ -2,108 +2,397 @@
 package sicclient
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
 	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
 )
 
+// Names of the SIC API endpoints, used to label request metrics.
+const (
+	endpointUsageByEntity = "usage-by-entity"
+	endpointUsageSeries   = "usage-series"
+)
+
+// apiPath is the path of the SIC API endpoints below the base URL.
+const apiPath = "/sustainability-insight-ctr/v1beta1/"
+
+// DefaultPageSize is the number of items requested per page when paginating through SIC results.
+const DefaultPageSize = 100
+
+// Defaults used for unset (zero) fields of Config.
+const (
+	DefaultRequestTimeout          = 30 * time.Second
+	DefaultMaxRetries              = 3
+	DefaultInitialBackoff          = 500 * time.Millisecond
+	DefaultMaxBackoff              = 30 * time.Second
+	DefaultBreakerFailureThreshold = 5
+	DefaultBreakerOpenDuration     = time.Minute
+)
+
 // Config holds the configuration needed to initialize the SIC API client.
 type Config struct {
-	Hostname    string      // Hostname for the SIC API
-	TokenConfig TokenConfig // Config for token generation and management
+	Hostname       string               // Hostname for the SIC API, reached over HTTPS on the default port
+	BaseURL        string               // Base URL of the SIC API, e.g. "http://localhost:8080", overriding Hostname
+	HTTPClient     *http.Client         // Client for requests to SIC and the token URL, a default client if nil
+	TokenConfig    TokenConfig          // Config for token generation and management
+	RequestTimeout time.Duration        // Deadline of a single request attempt
+	Retry          RetryConfig          // Config for retrying failed requests
+	CircuitBreaker CircuitBreakerConfig // Config for the circuit breaker
+}
+
+// RetryConfig holds the configuration of the retries of requests failing with a network
+// error, 429 Too Many Requests, or a 5xx status code. Requests failing with 401 Unauthorized
+// are retried as well, with a newly generated access token.
+type RetryConfig struct {
+	MaxRetries     int           // Maximum number of retries after the first attempt, negative to disable retries
+	InitialBackoff time.Duration // Backoff before the first retry, doubled on every retry
+	MaxBackoff     time.Duration // Upper bound of the backoff and of the delays SIC asks for with Retry-After
+}
+
+// CircuitBreakerConfig holds the configuration of the circuit breaker, which stops requests
+// to SIC after repeated failures.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // Consecutive failed requests opening the breaker
+	OpenDuration     time.Duration // Time the breaker stays open before a probe request is allowed
+}
+
+// StatusError is returned when SIC responds with a status code other than 200 OK.
+type StatusError struct {
+	StatusCode int           // HTTP status code
+	Status     string        // HTTP status
+	Body       string        // Response body
+	RetryAfter time.Duration // Delay requested by the Retry-After header, if any
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("API returned status %s: %s", e.Status, e.Body)
+}
+
+// Retryable returns whether the request may succeed when retried.
+func (e *StatusError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
 }
 
 // Client represents the main client that interacts with SIC APIs.
 type Client struct {
-	hostname     string
-	tokenManager *TokenManager
+	baseURL        string
+	tokenManager   *TokenManager
+	httpClient     *http.Client
+	requestTimeout time.Duration
+	retry          RetryConfig
+	breaker        *circuitBreaker
 }
 
 // New initializes a new SIC Client with the given hostname and token manager.
 func New(config Config) *Client {
 	tokenManager := NewTokenManager(config.TokenConfig)
+
+	if config.RequestTimeout <= 0 {
+		config.RequestTimeout = DefaultRequestTimeout
+	}
+	if config.Retry.MaxRetries < 0 {
+		config.Retry.MaxRetries = 0
+	} else if config.Retry.MaxRetries == 0 {
+		config.Retry.MaxRetries = DefaultMaxRetries
+	}
+	if config.Retry.InitialBackoff <= 0 {
+		config.Retry.InitialBackoff = DefaultInitialBackoff
+	}
+	if config.Retry.MaxBackoff <= 0 {
+		config.Retry.MaxBackoff = DefaultMaxBackoff
+	}
+	if config.CircuitBreaker.FailureThreshold <= 0 {
+		config.CircuitBreaker.FailureThreshold = DefaultBreakerFailureThreshold
+	}
+	if config.CircuitBreaker.OpenDuration <= 0 {
+		config.CircuitBreaker.OpenDuration = DefaultBreakerOpenDuration
+	}
+
+	baseURL := strings.TrimSuffix(config.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = "https://" + config.Hostname
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	tokenManager.httpClient = httpClient
+
 	return &Client{
-		hostname:     config.Hostname,
-		tokenManager: tokenManager,
+		baseURL:        baseURL,
+		tokenManager:   tokenManager,
+		httpClient:     httpClient,
+		requestTimeout: config.RequestTimeout,
+		retry:          config.Retry,
+		breaker:        newCircuitBreaker(config.CircuitBreaker.FailureThreshold, config.CircuitBreaker.OpenDuration),
 	}
 }
 
+// NewHTTPClient creates an HTTP client trusting the CA certificates in the given PEM file in
+// addition to the system ones, e.g. those of a private SIC deployment or a test server.
+func NewHTTPClient(caFile string) (*http.Client, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA file %s: %w", caFile, err)
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no CA certificates found in %s", caFile)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	return &http.Client{Transport: transport}, nil
+}
+
 // GetUsageByEntity fetches usage data by entity within the specified time range,
 // applying optional filters, sorting, and pagination.
-func (c *Client) GetUsageByEntity(startTime, endTime string, parameters *sicparams.Params) (*sicresponse.UsageByEntityResponse, error) {
-	apiURL := fmt.Sprintf("https://%s/sustainability-insight-ctr/v1beta1/usage-by-entity?start-time=%s&end-time=%s",
-		c.hostname, url.QueryEscape(startTime), url.QueryEscape(endTime))
+func (c *Client) GetUsageByEntity(ctx context.Context, startTime, endTime string, parameters *sicparams.Params) (*sicresponse.UsageByEntityResponse, error) {
+	apiURL := fmt.Sprintf("%s%s%s?start-time=%s&end-time=%s",
+		c.baseURL, apiPath, endpointUsageByEntity, url.QueryEscape(startTime), url.QueryEscape(endTime))
 
 	if parameters != nil {
 		apiURL = fmt.Sprintf("%s&%s", apiURL, parameters.ToQueryParams().Encode())
 	}
 
 	var usageResponse sicresponse.UsageByEntityResponse
-	if err := c.doGetRequest(apiURL, &usageResponse); err != nil {
+	if err := c.doGetRequest(ctx, endpointUsageByEntity, apiURL, &usageResponse); err != nil {
 		return nil, err
 	}
 	return &usageResponse, nil
 }
 
+// GetAllUsageByEntity fetches every usage entity matching the given parameters, following
+// the offset/limit pagination of the usage-by-entity endpoint until all items are retrieved.
+// Any offset or limit already set on the parameters is overridden. Unless the parameters sort
+// the items, they are sorted by entity ID, so that pages do not overlap or skip items.
+func (c *Client) GetAllUsageByEntity(ctx context.Context, startTime, endTime string, parameters *sicparams.Params) (*sicresponse.UsageByEntityResponse, error) {
+	// Pagination and sorting are added to a copy, leaving the parameters of the caller unchanged.
+	if parameters == nil {
+		parameters = sicparams.New()
+	} else {
+		parameters = parameters.Clone()
+	}
+	if !parameters.Sorted() {
+		sort, err := sicparams.NewSort(sicparams.SortByEntityID, sicparams.Ascending)
+		if err != nil {
+			return nil, err
+		}
+		parameters.AddSort(sort)
+	}
+
+	allItems := &sicresponse.UsageByEntityResponse{}
+	for offset := 0; ; {
+		parameters.AddOffset(sicparams.NewOffset(offset)).AddLimit(sicparams.NewLimit(DefaultPageSize))
+
+		page, err := c.GetUsageByEntity(ctx, startTime, endTime, parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		allItems.Items = append(allItems.Items, page.Items...)
+		allItems.Total = page.Total
+		offset += len(page.Items)
+
+		// Stop once the server reports no more items, or returns an empty page
+		// to protect against looping forever on an inconsistent total.
+		if len(page.Items) == 0 || offset >= page.Total {
+			break
+		}
+	}
+
+	allItems.Count = len(allItems.Items)
+	return allItems, nil
+}
+
 // GetUsageSeries fetches usage data over a time series with specified intervals.
-// It applies optional filters, sorting, and pagination.
-func (c *Client) GetUsageSeries(startTime, endTime, interval string, parameters *sicparams.Params) (*sicresponse.UsageSeriesResponse, error) {
-	apiURL := fmt.Sprintf("https://%s/sustainability-insight-ctr/v1beta1/usage-series?start-time=%s&end-time=%s&interval=%s",
-		c.hostname, url.QueryEscape(startTime), url.QueryEscape(endTime), url.QueryEscape(interval))
+// It applies optional filters, sorting, and pagination. The usage of all entities matching the
+// filters is summed per time bucket, and items do not identify the entities, so the series of
+// different entities cannot be told apart in a single response.
+func (c *Client) GetUsageSeries(ctx context.Context, startTime, endTime, interval string, parameters *sicparams.Params) (*sicresponse.UsageSeriesResponse, error) {
+	apiURL := fmt.Sprintf("%s%s%s?start-time=%s&end-time=%s&interval=%s",
+		c.baseURL, apiPath, endpointUsageSeries, url.QueryEscape(startTime), url.QueryEscape(endTime), url.QueryEscape(interval))
 
 	if parameters != nil {
 		apiURL = fmt.Sprintf("%s&%s", apiURL, parameters.ToQueryParams().Encode())
 	}
 
 	var usageSeriesResponse sicresponse.UsageSeriesResponse
-	if err := c.doGetRequest(apiURL, &usageSeriesResponse); err != nil {
+	if err := c.doGetRequest(ctx, endpointUsageSeries, apiURL, &usageSeriesResponse); err != nil {
 		return nil, err
 	}
 	return &usageSeriesResponse, nil
 }
 
 // doGetRequest is a helper method that performs a GET request and decodes the response into the provided response struct.
-func (c *Client) doGetRequest(apiURL string, response interface{}) error {
+// Attempts failing with a network error, 401 Unauthorized, 429 Too Many Requests or a 5xx status code are retried with a jittered
+// exponential backoff, waiting at least as long as requested by a Retry-After header. Requests asked to wait longer than
+// the maximum backoff, or than the context allows, fail right away. Requests are rejected with ErrCircuitOpen while the
+// circuit breaker is open. The endpoint name is used to label request metrics.
+func (c *Client) doGetRequest(ctx context.Context, endpoint, apiURL string, response interface{}) error {
+	if err := c.breaker.Allow(); err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		retryable, err := c.doGetRequestOnce(ctx, endpoint, apiURL, response)
+		if err == nil {
+			c.breaker.RecordSuccess()
+			return nil
+		}
+		if ctx.Err() != nil {
+			// The caller gave up, which says nothing about the health of SIC.
+			c.breaker.RecordAbandoned()
+			return err
+		}
+		if !retryable {
+			// SIC responded, so it is healthy even though the request is invalid.
+			c.breaker.RecordSuccess()
+			return err
+		}
+		if attempt >= c.retry.MaxRetries {
+			c.breaker.RecordFailure()
+			return fmt.Errorf("giving up after %d attempts: %w", attempt+1, err)
+		}
+
+		delay := c.backoff(attempt)
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > delay {
+			maxDelay := c.retry.MaxBackoff
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < maxDelay {
+				maxDelay = time.Until(deadline)
+			}
+			if statusErr.RetryAfter > maxDelay {
+				c.breaker.RecordFailure()
+				return fmt.Errorf("giving up, as SIC asked to retry after %v: %w", statusErr.RetryAfter, err)
+			}
+			delay = statusErr.RetryAfter
+		}
+		klog.V(4).InfoS("Retrying SIC request", "attempt", attempt+1, "delay", delay, "err", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			c.breaker.RecordAbandoned()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// doGetRequestOnce performs a single attempt of a GET request, bounded by the request timeout.
+// It returns whether a failed attempt may be retried, and records the latency and outcome of the attempt.
+func (c *Client) doGetRequestOnce(ctx context.Context, endpoint, apiURL string, response interface{}) (retryable bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	defer cancel()
+
 	// Get the token from the TokenManager
-	token, err := c.tokenManager.GetToken()
+	token, err := c.tokenManager.GetToken(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get access token: %v", err)
+		return true, fmt.Errorf("failed to get access token: %w", err)
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest("GET", apiURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+		return false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set Authorization header with the token
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
-	// Execute the request
-	resp, err := http.DefaultClient.Do(req)
+	// Execute the request, recording its latency and failures by status code
+	code := "error"
+	start := time.Now()
+	defer func() {
+		metrics.SICRequestDuration.WithLabelValues(endpoint, code).Observe(time.Since(start).Seconds())
+		if err != nil {
+			metrics.SICRequestErrors.WithLabelValues(endpoint, code).Inc()
+		}
+	}()
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("API request failed: %v", err)
+		return true, fmt.Errorf("API request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	code = strconv.Itoa(resp.StatusCode)
 
 	// Check for non-200 status codes
 	if resp.StatusCode != http.StatusOK {
+		statusErr := &StatusError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
 		body, readErr := io.ReadAll(resp.Body) // Read body for better error handling
 		if readErr != nil {
-			return fmt.Errorf("API returned status %s, failed to read body: %v", resp.Status, readErr)
+			statusErr.Body = fmt.Sprintf("failed to read body: %v", readErr)
+		} else {
+			statusErr.Body = string(body)
 		}
-		return fmt.Errorf("API returned status %s: %s", resp.Status, string(body))
+		if resp.StatusCode == http.StatusUnauthorized {
+			// The token was revoked or the credentials rotated; retry with a new token.
+			c.tokenManager.InvalidateToken()
+			return true, statusErr
+		}
+		return statusErr.Retryable(), statusErr
 	}
 
 	// Unmarshal the response body into the provided response struct
 	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
-		return fmt.Errorf("failed to unmarshal response: %v", err)
+		return false, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return false, nil
+}
+
+// backoff returns the delay before the given retry, drawn uniformly between zero and an
+// exponentially growing upper bound ("full jitter"), so that concurrent clients spread out.
+func (c *Client) backoff(attempt int) time.Duration {
+	upperBound := c.retry.InitialBackoff
+	for i := 0; i < attempt && upperBound < c.retry.MaxBackoff; i++ {
+		upperBound *= 2
 	}
+	if upperBound > c.retry.MaxBackoff {
+		upperBound = c.retry.MaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(upperBound) + 1))
+}
 
-	return nil
+// parseRetryAfter parses the value of a Retry-After header, given either in seconds or as an
+// HTTP date. It returns 0 if the header is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
 }
@@ -0,0 +1,290 @@
+package sicclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/component-base/metrics/testutil"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/metrics"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicfake"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicparams"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+)
+
+// newTestClient creates a Client talking to a fake SIC server serving the token endpoint
+// and delegating all other requests to the given handler.
+func newTestClient(t *testing.T, config Config, handler http.HandlerFunc) *Client {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	config.BaseURL = server.URL
+	config.HTTPClient = server.Client()
+	config.TokenConfig = TokenConfig{URL: server.URL + "/token", ClientID: "id", ClientSecret: StaticClientSecret("secret")}
+	return New(config)
+}
+
+func TestClientRetriesHonouringRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, Config{Retry: RetryConfig{InitialBackoff: time.Millisecond}}, func(w http.ResponseWriter, r *http.Request) {
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Write([]byte(`{"count": 0, "total": 0, "items": []}`))
+		}
+	})
+
+	start := time.Now()
+	if _, err := c.GetUsageByEntity(context.Background(), "start", "end", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the client to wait for the Retry-After delay, returned after %v", elapsed)
+	}
+}
+
+func TestClientGivesUpOnLongRetryAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		timeout time.Duration
+	}{
+		{
+			name:   "beyond the maximum backoff",
+			config: Config{Retry: RetryConfig{MaxBackoff: time.Second}},
+		},
+		{
+			name:    "beyond the context deadline",
+			timeout: 5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			c := newTestClient(t, tt.config, func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Retry-After", "20")
+				w.WriteHeader(http.StatusTooManyRequests)
+			})
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			_, err := c.GetUsageByEntity(ctx, "start", "end", nil)
+			var statusErr *StatusError
+			if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests {
+				t.Fatalf("expected a 429 StatusError, got %v", err)
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("expected a single attempt, got %d", got)
+			}
+			if elapsed := time.Since(start); elapsed >= time.Second {
+				t.Errorf("expected the client to give up right away, returned after %v", elapsed)
+			}
+		})
+	}
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := c.GetUsageByEntity(context.Background(), "start", "end", nil)
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a 400 StatusError, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single attempt, got %d", got)
+	}
+}
+
+func TestClientRecordsRequestMetrics(t *testing.T) {
+	metrics.Register()
+	c := newTestClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	errorsCounter := metrics.SICRequestErrors.WithLabelValues(endpointUsageSeries, "404")
+	before, err := testutil.GetCounterMetricValue(errorsCounter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetUsageSeries(context.Background(), "start", "end", "1h", nil); err == nil {
+		t.Fatalf("expected an error for a 404 response")
+	}
+	after, err := testutil.GetCounterMetricValue(errorsCounter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after-before != 1 {
+		t.Errorf("expected a single failed request to be recorded, got %v", after-before)
+	}
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	c := newTestClient(t, Config{
+		Retry:          RetryConfig{MaxRetries: -1},
+		CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Minute},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"count": 0, "total": 0, "items": []}`))
+	})
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetUsageByEntity(context.Background(), "start", "end", nil); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected request %d to fail with a server error, got %v", i, err)
+		}
+	}
+	if _, err := c.GetUsageByEntity(context.Background(), "start", "end", nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit breaker to be open, got %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected no request to reach SIC while the breaker is open, got %d requests", got)
+	}
+
+	// Once the open duration has elapsed, a successful probe closes the breaker.
+	healthy.Store(true)
+	now = now.Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetUsageByEntity(context.Background(), "start", "end", nil); err != nil {
+			t.Fatalf("expected request to succeed after the open duration, got %v", err)
+		}
+	}
+}
+
+func TestClientCircuitBreakerAbandonedProbe(t *testing.T) {
+	c := newTestClient(t, Config{
+		Retry:          RetryConfig{MaxRetries: -1},
+		CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 0, "total": 0, "items": []}`))
+	})
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+	c.breaker.RecordFailure()
+
+	// The caller of the probe request gives up before SIC responds.
+	now = now.Add(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetUsageByEntity(ctx, "start", "end", nil); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the probe request to fail with the cancelled context, got %v", err)
+	}
+	if _, err := c.GetUsageByEntity(context.Background(), "start", "end", nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit breaker to re-open after the abandoned probe, got %v", err)
+	}
+
+	// Once the open duration has elapsed again, another probe is let through.
+	now = now.Add(time.Minute)
+	if _, err := c.GetUsageByEntity(context.Background(), "start", "end", nil); err != nil {
+		t.Fatalf("expected a new probe request to be allowed, got %v", err)
+	}
+}
+
+func TestGetAllUsageByEntityPaginates(t *testing.T) {
+	// Entities are stored out of order, so that pages only line up if they are sorted.
+	var entities []sicfake.Entity
+	for i := 2*DefaultPageSize + 50; i > 0; i-- {
+		entityMake := "HPE"
+		if i%2 == 0 {
+			entityMake = "Dell"
+		}
+		entities = append(entities, sicfake.Entity{UsageEntity: sicresponse.UsageEntity{
+			EntityID:        fmt.Sprintf("id-%03d", i),
+			EntitySerialNum: fmt.Sprintf("SN%03d", i),
+			EntityMake:      entityMake,
+		}})
+	}
+	entities = append(entities, sicfake.Entity{UsageEntity: sicresponse.UsageEntity{EntityID: "id-other", EntityMake: "Lenovo"}})
+	server := sicfake.NewServer(entities...)
+	t.Cleanup(server.Close)
+	c := New(Config{
+		BaseURL:     server.BaseURL(),
+		HTTPClient:  server.Client(),
+		TokenConfig: TokenConfig{URL: server.TokenURL(), ClientID: sicfake.ClientID, ClientSecret: StaticClientSecret(sicfake.ClientSecret)},
+	})
+
+	hpe, _ := sicparams.NewFilter(sicparams.FilterKeyEntityMake, sicparams.FilterOperatorEquals, "HPE")
+	dell, _ := sicparams.NewFilter(sicparams.FilterKeyEntityMake, sicparams.FilterOperatorEquals, "Dell")
+	filter, err := sicparams.Or(hpe, dell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := sicparams.New().AddFilter(filter)
+	query := params.ToQueryParams().Encode()
+	response, err := c.GetAllUsageByEntity(context.Background(), "start", "end", params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := params.ToQueryParams().Encode(); got != query {
+		t.Errorf("expected the parameters of the caller to be left unchanged, got %s", got)
+	}
+
+	if response.Count != 2*DefaultPageSize+50 || response.Total != response.Count {
+		t.Fatalf("expected %d entities, got count %d and total %d", 2*DefaultPageSize+50, response.Count, response.Total)
+	}
+	for i, entity := range response.Items {
+		if want := fmt.Sprintf("id-%03d", i+1); entity.EntityID != want {
+			t.Fatalf("expected entity %d to be %s, got %s", i, want, entity.EntityID)
+		}
+	}
+	if got := server.Requests(sicfake.EndpointUsageByEntity); got != 3 {
+		t.Errorf("expected 3 pages to be requested, got %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "120", want: 2 * time.Minute},
+		{value: "-1", want: 0},
+		{value: "Mon, 01 Jan 2024 00:00:30 GMT", want: 30 * time.Second},
+		{value: "Sun, 31 Dec 2023 23:59:00 GMT", want: 0},
+		{value: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,460 @@
+// Package sicfake provides a fake Sustainability Insight Center (SIC) API and OAuth token
+// endpoint, serving scripted usage data and faults over TLS, for tests of the SIC client and
+// of the GreenScheduling plugin.
+package sicfake
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+)
+
+// Endpoints of the fake server, to inject faults into and count requests of.
+const (
+	EndpointToken         = "token"
+	EndpointUsageByEntity = "usage-by-entity"
+	EndpointUsageSeries   = "usage-series"
+)
+
+// Credentials accepted by the token endpoint.
+const (
+	ClientID     = "client-id"
+	ClientSecret = "client-secret"
+)
+
+const (
+	apiPath     = "/sustainability-insight-ctr/v1beta1/"
+	accessToken = "access-token"
+)
+
+// Entity is an entity known to the fake server, with its usage and usage series.
+type Entity struct {
+	sicresponse.UsageEntity
+	Series []sicresponse.UsageSeriesItem
+}
+
+// Fault is a response returned instead of the scripted one.
+type Fault struct {
+	StatusCode int           // HTTP status code of the response
+	RetryAfter time.Duration // Value of the Retry-After header, omitted if 0
+	Body       string        // Response body
+}
+
+// Server is a fake SIC API and token endpoint. Both usage endpoints support the eq, in and
+// contains filters, composed with and and or. The usage-by-entity endpoint also supports sort,
+// offset and limit. The usage-series endpoint aggregates the series of all matching entities per
+// time bucket, like SIC does.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	entities []Entity
+	faults   map[string][]Fault
+	requests map[string]int
+}
+
+// NewServer starts a fake SIC server serving the given entities. Close it when done.
+func NewServer(entities ...Entity) *Server {
+	s := &Server{
+		entities: entities,
+		faults:   map[string][]Fault{},
+		requests: map[string]int{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", s.handleToken)
+	mux.HandleFunc(apiPath+EndpointUsageByEntity, s.handleUsageByEntity)
+	mux.HandleFunc(apiPath+EndpointUsageSeries, s.handleUsageSeries)
+	s.Server = httptest.NewTLSServer(mux)
+	return s
+}
+
+// BaseURL returns the base URL of the SIC API.
+func (s *Server) BaseURL() string {
+	return s.URL
+}
+
+// TokenURL returns the URL of the token endpoint.
+func (s *Server) TokenURL() string {
+	return s.URL + "/token"
+}
+
+// WriteCAFile writes the certificate of the server, to be trusted by clients, as a PEM file.
+func (s *Server) WriteCAFile(path string) error {
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw}
+	return os.WriteFile(path, pem.EncodeToMemory(block), 0o600)
+}
+
+// SetEntities replaces the entities known to the server.
+func (s *Server) SetEntities(entities ...Entity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entities = entities
+}
+
+// InjectFaults makes the next requests to the endpoint fail with the given faults, in order.
+func (s *Server) InjectFaults(endpoint string, faults ...Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[endpoint] = append(s.faults[endpoint], faults...)
+}
+
+// Requests returns the number of requests received by the endpoint, including failed ones.
+func (s *Server) Requests(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[endpoint]
+}
+
+// begin counts a request to the endpoint and writes the next fault injected into it, if any.
+// It returns false if a fault was written.
+func (s *Server) begin(w http.ResponseWriter, endpoint string) bool {
+	s.mu.Lock()
+	s.requests[endpoint]++
+	faults := s.faults[endpoint]
+	if len(faults) == 0 {
+		s.mu.Unlock()
+		return true
+	}
+	fault := faults[0]
+	s.faults[endpoint] = faults[1:]
+	s.mu.Unlock()
+
+	if fault.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Seconds())))
+	}
+	http.Error(w, fault.Body, fault.StatusCode)
+	return false
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if !s.begin(w, EndpointToken) {
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.PostFormValue("client_id") != ClientID || r.PostFormValue("client_secret") != ClientSecret {
+		http.Error(w, "invalid client credentials", http.StatusUnauthorized)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) handleUsageByEntity(w http.ResponseWriter, r *http.Request) {
+	entities, ok := s.matchingEntities(w, r, EndpointUsageByEntity)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	for i := len(query["sort"]) - 1; i >= 0; i-- {
+		if err := sortEntities(entities, query["sort"][i]); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	offset, limit := 0, len(entities)
+	var err error
+	if value := query.Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil || offset < 0 {
+			http.Error(w, "invalid offset", http.StatusBadRequest)
+			return
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	response := sicresponse.UsageByEntityResponse{Items: []sicresponse.UsageEntity{}, Total: len(entities), Offset: offset}
+	for i := offset; i < len(entities) && i < offset+limit; i++ {
+		response.Items = append(response.Items, entities[i].UsageEntity)
+	}
+	response.Count = len(response.Items)
+	writeJSON(w, response)
+}
+
+func (s *Server) handleUsageSeries(w http.ResponseWriter, r *http.Request) {
+	entities, ok := s.matchingEntities(w, r, EndpointUsageSeries)
+	if !ok {
+		return
+	}
+	if r.URL.Query().Get("interval") == "" {
+		http.Error(w, "missing interval", http.StatusBadRequest)
+		return
+	}
+
+	buckets := map[string]*sicresponse.UsageSeriesItem{}
+	for _, entity := range entities {
+		for _, item := range entity.Series {
+			bucket, ok := buckets[item.TimeBucket]
+			if !ok {
+				bucket = &sicresponse.UsageSeriesItem{ID: item.TimeBucket, Type: "usage-series", TimeBucket: item.TimeBucket}
+				buckets[item.TimeBucket] = bucket
+			}
+			bucket.CostUsd = add(bucket.CostUsd, item.CostUsd)
+			bucket.Co2eMetricTon = add(bucket.Co2eMetricTon, item.Co2eMetricTon)
+			bucket.Kwh = add(bucket.Kwh, item.Kwh)
+		}
+	}
+	response := sicresponse.UsageSeriesResponse{Items: []sicresponse.UsageSeriesItem{}}
+	for _, bucket := range buckets {
+		response.Items = append(response.Items, *bucket)
+	}
+	sort.Slice(response.Items, func(i, j int) bool { return response.Items[i].TimeBucket < response.Items[j].TimeBucket })
+	response.Count = len(response.Items)
+	writeJSON(w, response)
+}
+
+// matchingEntities authenticates a request to the endpoint and returns the entities matching all
+// of its filters. It returns false if an error was written instead.
+func (s *Server) matchingEntities(w http.ResponseWriter, r *http.Request, endpoint string) ([]Entity, bool) {
+	if !s.begin(w, endpoint) {
+		return nil, false
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+	if r.Header.Get("Authorization") != "Bearer "+accessToken {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return nil, false
+	}
+	query := r.URL.Query()
+	if query.Get("start-time") == "" || query.Get("end-time") == "" {
+		http.Error(w, "missing start-time or end-time", http.StatusBadRequest)
+		return nil, false
+	}
+	var filters []filter
+	for _, expression := range query["filter"] {
+		f, err := parseFilter(expression)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return nil, false
+		}
+		filters = append(filters, f)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entities []Entity
+	for _, entity := range s.entities {
+		matches := true
+		for _, f := range filters {
+			matches = matches && f.matches(&entity.UsageEntity)
+		}
+		if matches {
+			entities = append(entities, entity)
+		}
+	}
+	return entities, true
+}
+
+// sortEntities sorts the entities by a sort expression, e.g. "entityId asc", keeping the order of
+// entities with equal keys.
+func sortEntities(entities []Entity, expression string) error {
+	key, order, _ := strings.Cut(expression, " ")
+	if _, ok := field(&sicresponse.UsageEntity{}, key); !ok || (order != "asc" && order != "desc") {
+		return fmt.Errorf("invalid sort %q", expression)
+	}
+	sort.SliceStable(entities, func(i, j int) bool {
+		a, _ := field(&entities[i].UsageEntity, key)
+		b, _ := field(&entities[j].UsageEntity, key)
+		if order == "desc" {
+			return a > b
+		}
+		return a < b
+	})
+	return nil
+}
+
+var (
+	filterPattern = regexp.MustCompile(`^(?:(\w+) (eq|in) (.+)|contains\((\w+), ('.*')\))$`)
+	valuePattern  = regexp.MustCompile(`'((?:[^']|'')*)'`)
+)
+
+// filter is a parsed filter expression, e.g. "entitySerialNum in ('SN1', 'SN2')", or a
+// composition of filters with and or or.
+type filter struct {
+	key      string
+	operator string
+	values   []string
+	operands []filter
+}
+
+// parseFilter parses an eq, in or contains filter expression, or a composition of parenthesized
+// expressions with either and or or, e.g. "(entityMake eq 'HPE') or (entityMake eq 'Dell')".
+// Quotes within values are escaped by doubling them.
+func parseFilter(expression string) (filter, error) {
+	if strings.HasPrefix(expression, "(") {
+		return parseComposite(expression)
+	}
+	match := filterPattern.FindStringSubmatch(expression)
+	if match == nil {
+		return filter{}, fmt.Errorf("invalid filter %q", expression)
+	}
+	f := filter{key: match[1], operator: match[2]}
+	values := match[3]
+	if f.key == "" {
+		f.key, f.operator, values = match[4], "contains", match[5]
+	}
+	if f.operator == "in" {
+		values = strings.TrimSuffix(strings.TrimPrefix(values, "("), ")")
+	}
+	for _, value := range valuePattern.FindAllStringSubmatch(values, -1) {
+		f.values = append(f.values, strings.ReplaceAll(value[1], "''", "'"))
+	}
+	if len(f.values) == 0 || (f.operator != "in" && len(f.values) != 1) {
+		return filter{}, fmt.Errorf("invalid filter %q", expression)
+	}
+	if _, ok := field(&sicresponse.UsageEntity{}, f.key); !ok {
+		return filter{}, fmt.Errorf("invalid filter key %q", f.key)
+	}
+	return f, nil
+}
+
+// parseComposite parses parenthesized filter expressions joined by the same operator, skipping
+// parentheses within quoted values.
+func parseComposite(expression string) (filter, error) {
+	invalid := fmt.Errorf("invalid filter %q", expression)
+	var f filter
+	for rest := expression; ; {
+		depth, quoted, end := 0, false, -1
+		for i := 0; i < len(rest) && end < 0; i++ {
+			switch {
+			case rest[i] == '\'':
+				quoted = !quoted
+			case quoted:
+			case rest[i] == '(':
+				depth++
+			case rest[i] == ')':
+				if depth--; depth == 0 {
+					end = i
+				}
+			}
+		}
+		if !strings.HasPrefix(rest, "(") || end < 0 {
+			return filter{}, invalid
+		}
+		operand, err := parseFilter(rest[1:end])
+		if err != nil {
+			return filter{}, err
+		}
+		f.operands = append(f.operands, operand)
+
+		rest = rest[end+1:]
+		if rest == "" {
+			break
+		}
+		operator, next, ok := strings.Cut(strings.TrimPrefix(rest, " "), " ")
+		if !ok || (operator != "and" && operator != "or") || (f.operator != "" && operator != f.operator) {
+			return filter{}, invalid
+		}
+		f.operator, rest = operator, next
+	}
+	if len(f.operands) < 2 {
+		return filter{}, invalid
+	}
+	return f, nil
+}
+
+// matches returns whether the entity matches the filter.
+func (f filter) matches(entity *sicresponse.UsageEntity) bool {
+	switch f.operator {
+	case "and":
+		for _, operand := range f.operands {
+			if !operand.matches(entity) {
+				return false
+			}
+		}
+		return true
+	case "or":
+		for _, operand := range f.operands {
+			if operand.matches(entity) {
+				return true
+			}
+		}
+		return false
+	}
+	value, _ := field(entity, f.key)
+	for _, want := range f.values {
+		if value == want || (f.operator == "contains" && strings.Contains(value, want)) {
+			return true
+		}
+	}
+	return false
+}
+
+// field returns the value of the field of the entity with the given filter key.
+func field(entity *sicresponse.UsageEntity, key string) (string, bool) {
+	switch key {
+	case "entityId":
+		return entity.EntityID, true
+	case "entityMake":
+		return entity.EntityMake, true
+	case "entityModel":
+		return entity.EntityModel, true
+	case "entityType":
+		return entity.EntityType, true
+	case "entitySerialNum":
+		return entity.EntitySerialNum, true
+	case "entityProductId":
+		return entity.EntityProductID, true
+	case "locationName":
+		return deref(entity.LocationName), true
+	case "locationId":
+		return deref(entity.LocationID), true
+	case "locationCity":
+		return deref(entity.LocationCity), true
+	case "locationState":
+		return deref(entity.LocationState), true
+	case "locationCountry":
+		return deref(entity.LocationCountry), true
+	case "name":
+		return entity.Name, true
+	}
+	return "", false
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// add returns the sum of two nullable values, nil if both are nil.
+func add(a, b *float64) *float64 {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	sum := *a + *b
+	return &sum
+}
+
+func writeJSON(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
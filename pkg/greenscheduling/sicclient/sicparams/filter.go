@@ -32,6 +32,10 @@ const (
 	FilterOperatorEquals   FilterOperator = "eq"
 	FilterOperatorContains FilterOperator = "contains"
 	FilterOperatorIn       FilterOperator = "in"
+
+	// FilterOperatorAnd and FilterOperatorOr compose filters, see And and Or.
+	FilterOperatorAnd FilterOperator = "and"
+	FilterOperatorOr  FilterOperator = "or"
 )
 
 // Filter represents a single filter condition.
@@ -39,6 +43,7 @@ type Filter struct {
 	Key      FilterKey
 	Operator FilterOperator
 	Value    interface{} // Change Value to interface{} to allow flexibility
+	Filters  []Filter    // Filters composed by the and and or operators
 }
 
 // NewFilter creates a new Filter instance.
@@ -49,9 +54,37 @@ func NewFilter(key FilterKey, operator FilterOperator, value interface{}) (*Filt
 	if !isValidOperator(operator) {
 		return nil, fmt.Errorf("invalid filter operator: %s", operator)
 	}
+	if _, ok := value.([]string); operator == FilterOperatorIn && !ok {
+		return nil, fmt.Errorf("invalid value of in filter: expected []string, got %T", value)
+	}
 	return &Filter{Key: key, Operator: operator, Value: value}, nil
 }
 
+// And creates a filter matching the entities that match all of the given filters.
+func And(filters ...*Filter) (*Filter, error) {
+	return compose(FilterOperatorAnd, filters)
+}
+
+// Or creates a filter matching the entities that match any of the given filters.
+func Or(filters ...*Filter) (*Filter, error) {
+	return compose(FilterOperatorOr, filters)
+}
+
+// compose creates a filter composing the given filters with a logical operator.
+func compose(operator FilterOperator, filters []*Filter) (*Filter, error) {
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("%s filter requires at least one filter", operator)
+	}
+	composed := &Filter{Operator: operator, Filters: make([]Filter, len(filters))}
+	for i, filter := range filters {
+		if filter == nil {
+			return nil, fmt.Errorf("%s filter requires non-nil filters", operator)
+		}
+		composed.Filters[i] = *filter
+	}
+	return composed, nil
+}
+
 // isValidKey checks if the filter key is valid.
 func isValidKey(key FilterKey) bool {
 	switch key {
@@ -72,24 +105,43 @@ func isValidOperator(operator FilterOperator) bool {
 	return false
 }
 
-// GetValue returns the value representation of the Filter.
-// For example: "entityId eq 'value'" or "contains(entityMake, 'value')"
+// GetValue returns the value representation of the Filter, with the quotes in values escaped by
+// doubling them. For example: "entityId eq 'value'", "contains(entityMake, 'value')", or for
+// composed filters "(entityMake eq 'HPE') and (locationCity in ('Berlin', 'Paris'))".
 func (f *Filter) GetValue() string {
-	if f.Operator == FilterOperatorIn {
+	switch f.Operator {
+	case FilterOperatorAnd, FilterOperatorOr:
+		if len(f.Filters) == 1 {
+			return f.Filters[0].GetValue()
+		}
+		operands := make([]string, len(f.Filters))
+		for i := range f.Filters {
+			operands[i] = "(" + f.Filters[i].GetValue() + ")"
+		}
+		return strings.Join(operands, " "+string(f.Operator)+" ")
+	case FilterOperatorIn:
 		// If the value is a slice, format it as a string
 		values := f.Value.([]string) // Assuming Value is a slice of strings for "in" operator
 		return fmt.Sprintf("%s in (%s)", f.Key, formatInValues(values))
+	case FilterOperatorContains:
+		return fmt.Sprintf("contains(%s, %s)", f.Key, quote(fmt.Sprint(f.Value)))
 	}
-	if f.Operator == FilterOperatorContains {
-		return fmt.Sprintf("contains(%s, '%v')", f.Key, f.Value)
-	}
-	return fmt.Sprintf("%s %s '%v'", f.Key, f.Operator, f.Value)
+	return fmt.Sprintf("%s %s %s", f.Key, f.Operator, quote(fmt.Sprint(f.Value)))
 }
 
-// formatInValues formats a slice of strings into the SQL-like syntax
+// formatInValues formats a slice of strings into the SQL-like syntax.
+// The input slice is left untouched so that a filter can be rendered more than once,
+// e.g. when the same params are reused across paginated requests.
 func formatInValues(values []string) string {
+	quoted := make([]string, len(values))
 	for i := range values {
-		values[i] = "'" + values[i] + "'"
+		quoted[i] = quote(values[i])
 	}
-	return strings.Join(values, ", ")
+	return strings.Join(quoted, ", ")
+}
+
+// quote quotes a value, escaping the quotes within it by doubling them, so that values such as
+// "O'Brien" cannot terminate the string or inject further filter expressions.
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
 }
@@ -0,0 +1,117 @@
+package sicparams
+
+import (
+	"testing"
+)
+
+func TestFilterGetValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      FilterKey
+		operator FilterOperator
+		value    interface{}
+		want     string
+	}{
+		{
+			name:     "equals",
+			key:      FilterKeyEntitySerialNum,
+			operator: FilterOperatorEquals,
+			value:    "SN1",
+			want:     "entitySerialNum eq 'SN1'",
+		},
+		{
+			name:     "contains",
+			key:      FilterKeyEntityMake,
+			operator: FilterOperatorContains,
+			value:    "HPE",
+			want:     "contains(entityMake, 'HPE')",
+		},
+		{
+			name:     "in",
+			key:      FilterKeyEntitySerialNum,
+			operator: FilterOperatorIn,
+			value:    []string{"SN1", "SN2"},
+			want:     "entitySerialNum in ('SN1', 'SN2')",
+		},
+		{
+			name:     "equals with quotes",
+			key:      FilterKeyLocationName,
+			operator: FilterOperatorEquals,
+			value:    "O'Brien' or name eq 'x",
+			want:     "locationName eq 'O''Brien'' or name eq ''x'",
+		},
+		{
+			name:     "contains with quotes",
+			key:      FilterKeyName,
+			operator: FilterOperatorContains,
+			value:    "it's",
+			want:     "contains(name, 'it''s')",
+		},
+		{
+			name:     "in with quotes",
+			key:      FilterKeyLocationCity,
+			operator: FilterOperatorIn,
+			value:    []string{"L'Aquila", "Rome"},
+			want:     "locationCity in ('L''Aquila', 'Rome')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewFilter(tt.key, tt.operator, tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// Render twice, as paginated requests reuse the same filter.
+			for i := 0; i < 2; i++ {
+				if got := filter.GetValue(); got != tt.want {
+					t.Errorf("GetValue() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestComposeFilters(t *testing.T) {
+	mustFilter := func(key FilterKey, operator FilterOperator, value interface{}) *Filter {
+		filter, err := NewFilter(key, operator, value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return filter
+	}
+	hpe := mustFilter(FilterKeyEntityMake, FilterOperatorEquals, "HPE")
+	cities := mustFilter(FilterKeyLocationCity, FilterOperatorIn, []string{"Berlin", "Paris"})
+	name := mustFilter(FilterKeyName, FilterOperatorContains, "gpu")
+
+	or, err := Or(cities, name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	and, err := And(hpe, or)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "(entityMake eq 'HPE') and ((locationCity in ('Berlin', 'Paris')) or (contains(name, 'gpu')))"
+	if got := and.GetValue(); got != want {
+		t.Errorf("GetValue() = %q, want %q", got, want)
+	}
+
+	single, err := And(hpe)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := single.GetValue(); got != hpe.GetValue() {
+		t.Errorf("expected a single composed filter to render as the filter itself, got %q", got)
+	}
+
+	if _, err := Or(); err == nil {
+		t.Errorf("expected an error composing no filters")
+	}
+	if _, err := NewFilter(FilterKeyName, FilterOperatorAnd, "x"); err == nil {
+		t.Errorf("expected an error creating a filter with a logical operator")
+	}
+	if _, err := NewFilter(FilterKeyName, FilterOperatorIn, "x"); err == nil {
+		t.Errorf("expected an error creating an in filter without a list of values")
+	}
+}
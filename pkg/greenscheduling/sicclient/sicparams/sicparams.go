@@ -25,6 +25,16 @@ func New() *Params {
 	}
 }
 
+// Clone returns a copy of the Params, so that conditions can be added without modifying the original.
+func (p *Params) Clone() *Params {
+	return &Params{
+		filters: append([]Filter{}, p.filters...),
+		sorts:   append([]Sort{}, p.sorts...),
+		offset:  p.offset,
+		limit:   p.limit,
+	}
+}
+
 // AddFilter adds a filter to the Params.
 func (p *Params) AddFilter(filter *Filter) *Params {
 	p.filters = append(p.filters, *filter)
@@ -37,6 +47,11 @@ func (p *Params) AddSort(sort *Sort) *Params {
 	return p
 }
 
+// Sorted returns whether a sort condition was added to the Params.
+func (p *Params) Sorted() bool {
+	return len(p.sorts) > 0
+}
+
 // AddOffset sets the offset in Params.
 func (p *Params) AddOffset(offset *Offset) *Params {
 	p.offset = offset
@@ -2,79 +2,246 @@ package sicclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
+
+	"golang.org/x/sync/singleflight"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/metrics"
 )
 
 // Constants for token management
 const (
-	grantType         = "client_credentials"
-	contentTypeHeader = "application/x-www-form-urlencoded"
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeRefreshToken      = "refresh_token"
+	contentTypeHeader          = "application/x-www-form-urlencoded"
+
+	// DefaultExpirySkew is how long before its expiry an access token is refreshed.
+	DefaultExpirySkew = 30 * time.Second
+
+	// defaultTokenLifetime is the lifetime assumed for access tokens issued without expires_in.
+	defaultTokenLifetime = 5 * time.Minute
+	// tokenRefreshTimeout bounds the refreshes of access tokens ahead of their expiry, which
+	// outlive the requests they were triggered by.
+	tokenRefreshTimeout = 30 * time.Second
 )
 
 // TokenResponse represents the structure of the token response.
 type TokenResponse struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"` // Include token type (e.g., "Bearer")
-	ExpiresIn   int    `json:"expires_in"` // The token's lifetime in seconds
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`              // Include token type (e.g., "Bearer")
+	ExpiresIn    int    `json:"expires_in"`              // The token's lifetime in seconds
+	RefreshToken string `json:"refresh_token,omitempty"` // Token for the refresh_token grant, if issued
 }
 
 // TokenConfig holds the configuration for the token generation.
 type TokenConfig struct {
-	URL          string // The URL for the token endpoint
-	ClientID     string // The client ID
-	ClientSecret string // The client secret
+	URL          string             // The URL for the token endpoint
+	ClientID     string             // The client ID
+	ClientSecret ClientSecretSource // The source of the client secret
+	ExpirySkew   time.Duration      // How long before its expiry a token is refreshed, DefaultExpirySkew if 0, at most half its lifetime
 }
 
 // TokenInfo holds the access token and its expiration details.
 type TokenInfo struct {
-	Token  string    // Current access token
-	Expiry time.Time // Expiration time of the current token
+	Token        string    // Current access token
+	Expiry       time.Time // Expiration time of the current token
+	RefreshAt    time.Time // Time from which the current token is refreshed ahead of its expiry
+	RefreshToken string    // Refresh token issued along with the current token, if any
 }
 
-// TokenManager is responsible for managing the access token.
+// TokenManager is responsible for managing the access token. It is safe for concurrent use:
+// tokens are refreshed in the background ahead of their expiry, while callers keep using them,
+// and concurrent callers needing a new token share a single request to the token endpoint.
 type TokenManager struct {
 	config     TokenConfig  // Configuration for token management
 	httpClient *http.Client // HTTP client for making requests
-	tokenInfo  TokenInfo    // Token information including current token and expiry
+
+	mu        sync.RWMutex
+	tokenInfo TokenInfo // Token information including current token and expiry
+	// clientSecret is the client secret the current token was generated with.
+	clientSecret string
+
+	// group deduplicates concurrent token requests.
+	group singleflight.Group
+
+	// now allows tests to control the clock.
+	now func() time.Time
 }
 
 // NewTokenManager creates a new instance of TokenManager.
 func NewTokenManager(config TokenConfig) *TokenManager {
+	if config.ExpirySkew <= 0 {
+		config.ExpirySkew = DefaultExpirySkew
+	}
 	return &TokenManager{
 		config:     config,
 		httpClient: &http.Client{},
+		now:        time.Now,
+	}
+}
+
+// GetToken returns the current access token, generating a new one if it expired. Tokens expiring
+// within the expiry skew are still returned, while they are refreshed in the background. The
+// cached token is dropped if the client secret changed since it was generated.
+func (tm *TokenManager) GetToken(ctx context.Context) (string, error) {
+	clientSecret, err := tm.config.ClientSecret.ClientSecret(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get client secret: %w", err)
+	}
+	if token, fresh, ok := tm.validToken(clientSecret); ok {
+		if !fresh {
+			tm.refreshInBackground(ctx, clientSecret)
+		}
+		return token, nil
 	}
+
+	// Let a single caller refresh the token on behalf of all concurrent callers using the
+	// same client secret.
+	token, err, _ := tm.group.Do(clientSecret, func() (interface{}, error) {
+		// The token may have been refreshed while waiting for the group.
+		if token, _, ok := tm.validToken(clientSecret); ok {
+			return token, nil
+		}
+		return tm.fetchToken(ctx, clientSecret)
+	})
+	if err != nil {
+		return "", err
+	}
+	return token.(string), nil
 }
 
-// GetToken returns the current access token and its type, generating a new one if necessary.
-func (tm *TokenManager) GetToken() (string, error) {
-	if time.Now().After(tm.tokenInfo.Expiry) { // Check if token is expired
-		if err := tm.RefreshToken(); err != nil {
-			return "", err
+// refreshInBackground refreshes the token ahead of its expiry without blocking the caller, in a
+// request shared with concurrent callers. The refresh is not cancelled along with the request of
+// the caller, but bounded by the token refresh timeout.
+func (tm *TokenManager) refreshInBackground(ctx context.Context, clientSecret string) {
+	ctx = context.WithoutCancel(ctx)
+	tm.group.DoChan(clientSecret, func() (interface{}, error) {
+		if token, fresh, ok := tm.validToken(clientSecret); ok && fresh {
+			return token, nil
+		}
+		ctx, cancel := context.WithTimeout(ctx, tokenRefreshTimeout)
+		defer cancel()
+		token, err := tm.fetchToken(ctx, clientSecret)
+		if err != nil {
+			klog.V(2).InfoS("Failed to refresh SIC access token ahead of its expiry", "err", err)
 		}
+		return token, err
+	})
+}
+
+// fetchToken refreshes the access token with the given client secret and returns it.
+func (tm *TokenManager) fetchToken(ctx context.Context, clientSecret string) (string, error) {
+	if err := tm.refresh(ctx, clientSecret); err != nil {
+		return "", err
 	}
-	return tm.tokenInfo.Token, nil // Return current access token
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.tokenInfo.Token, nil
 }
 
-// RefreshToken refreshes the access token.
-func (tm *TokenManager) RefreshToken() error {
-	return tm.GenerateToken() // Reuse GenerateToken logic to get a new token
+// validToken returns the cached token if it was generated with the given client secret and has
+// not expired, and whether it is fresh, i.e. not due to be refreshed ahead of its expiry yet.
+func (tm *TokenManager) validToken(clientSecret string) (token string, fresh bool, ok bool) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	if tm.tokenInfo.Token == "" || tm.clientSecret != clientSecret {
+		return "", false, false
+	}
+	now := tm.now()
+	if !now.Before(tm.tokenInfo.Expiry) {
+		return "", false, false
+	}
+	return tm.tokenInfo.Token, now.Before(tm.tokenInfo.RefreshAt), true
 }
 
-// GenerateToken generates a new access token.
-func (tm *TokenManager) GenerateToken() error {
+// InvalidateToken drops the cached access token, so that a new one is generated on the next request.
+func (tm *TokenManager) InvalidateToken() {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.tokenInfo = TokenInfo{}
+}
+
+// RefreshToken refreshes the access token, using the refresh_token grant if a refresh token
+// is available and falling back to generating a new token otherwise.
+func (tm *TokenManager) RefreshToken(ctx context.Context) error {
+	clientSecret, err := tm.config.ClientSecret.ClientSecret(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get client secret: %w", err)
+	}
+	return tm.refresh(ctx, clientSecret)
+}
+
+// GenerateToken generates a new access token with the client_credentials grant.
+func (tm *TokenManager) GenerateToken(ctx context.Context) error {
+	clientSecret, err := tm.config.ClientSecret.ClientSecret(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get client secret: %w", err)
+	}
+	return tm.generateToken(ctx, clientSecret)
+}
+
+// refresh refreshes the access token with the given client secret. The refresh token is only
+// used if it was issued for the same client secret.
+func (tm *TokenManager) refresh(ctx context.Context, clientSecret string) error {
+	tm.mu.Lock()
+	if tm.clientSecret != clientSecret && tm.tokenInfo.Token != "" {
+		// The credentials were rotated, so the cached token may have been revoked.
+		klog.InfoS("SIC client secret changed, dropping cached access token")
+		tm.tokenInfo = TokenInfo{}
+	}
+	refreshToken := tm.tokenInfo.RefreshToken
+	tm.mu.Unlock()
+
+	if refreshToken != "" {
+		data := url.Values{}
+		data.Set("grant_type", grantTypeRefreshToken)
+		data.Set("refresh_token", refreshToken)
+		data.Set("client_id", tm.config.ClientID)
+		data.Set("client_secret", clientSecret)
+		err := tm.requestToken(ctx, data, clientSecret)
+		if err == nil {
+			return nil
+		}
+		klog.V(2).InfoS("Failed to refresh SIC access token, generating a new one", "err", err)
+	}
+	return tm.generateToken(ctx, clientSecret)
+}
+
+// generateToken generates a new access token with the given client secret.
+func (tm *TokenManager) generateToken(ctx context.Context, clientSecret string) error {
 	data := url.Values{}
-	data.Set("grant_type", grantType)
+	data.Set("grant_type", grantTypeClientCredentials)
 	data.Set("client_id", tm.config.ClientID)
-	data.Set("client_secret", tm.config.ClientSecret)
+	data.Set("client_secret", clientSecret)
+	return tm.requestToken(ctx, data, clientSecret)
+}
+
+// requestToken requests an access token from the token endpoint and caches it, recording
+// the latency and failures of the request.
+func (tm *TokenManager) requestToken(ctx context.Context, data url.Values, clientSecret string) (err error) {
+	grantType := data.Get("grant_type")
+	start := time.Now()
+	defer func() {
+		metrics.TokenFetchDuration.WithLabelValues(grantType).Observe(time.Since(start).Seconds())
+		if err != nil {
+			metrics.TokenFetchFailures.WithLabelValues(grantType).Inc()
+		} else {
+			metrics.TokenRefreshes.WithLabelValues(grantType).Inc()
+		}
+	}()
 
 	dataEncoded := data.Encode() // Create the request body
 
-	req, err := http.NewRequest("POST", tm.config.URL, bytes.NewBufferString(dataEncoded))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tm.config.URL, bytes.NewBufferString(dataEncoded))
 	if err != nil {
 		return err
 	}
@@ -87,6 +254,10 @@ func (tm *TokenManager) GenerateToken() error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		// The credentials were rejected, so any cached token is no longer trusted either.
+		tm.InvalidateToken()
+	}
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("failed to generate token: %s", resp.Status)
 	}
@@ -96,9 +267,34 @@ func (tm *TokenManager) GenerateToken() error {
 		return err
 	}
 
+	// Keep using the previous refresh token if the server did not rotate it.
+	refreshToken := tokenResp.RefreshToken
+	if refreshToken == "" && grantType == grantTypeRefreshToken {
+		refreshToken = data.Get("refresh_token")
+	}
+
+	// Tokens are refreshed the expiry skew ahead of their expiry, but at most halfway through
+	// their lifetime, so that short-lived tokens are not refreshed on every request.
+	lifetime := time.Duration(tokenResp.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+	skew := tm.config.ExpirySkew
+	if skew > lifetime/2 {
+		skew = lifetime / 2
+	}
+
 	// Update token and expiry time
-	tm.tokenInfo.Token = tokenResp.AccessToken
-	tm.tokenInfo.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	issuedAt := tm.now()
+	tm.tokenInfo = TokenInfo{
+		Token:        tokenResp.AccessToken,
+		Expiry:       issuedAt.Add(lifetime),
+		RefreshAt:    issuedAt.Add(lifetime - skew),
+		RefreshToken: refreshToken,
+	}
+	tm.clientSecret = clientSecret
 
 	return nil
 }
@@ -0,0 +1,278 @@
+package sicclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// newTestTokenServer serves access tokens named after the client secret they were generated
+// with, and rejects the client secret "revoked".
+func newTestTokenServer(t *testing.T, requests *int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		clientSecret := r.PostForm.Get("client_secret")
+		if clientSecret == "revoked" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"access_token": "token-` + clientSecret + `", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTokenManagerPicksUpRotatedFileSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client-secret")
+	writeSecret := func(secret string) {
+		if err := os.WriteFile(path, []byte(secret+"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	requests := 0
+	server := newTestTokenServer(t, &requests)
+	tm := NewTokenManager(TokenConfig{URL: server.URL, ClientID: "id", ClientSecret: FileClientSecret{Path: path}})
+
+	getToken := func(want string) {
+		t.Helper()
+		token, err := tm.GetToken(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token != want {
+			t.Errorf("expected token %q, got %q", want, token)
+		}
+	}
+
+	writeSecret("first")
+	getToken("token-first")
+	getToken("token-first")
+	if requests != 1 {
+		t.Errorf("expected the token to be cached, got %d token requests", requests)
+	}
+
+	writeSecret("second")
+	getToken("token-second")
+	if requests != 2 {
+		t.Errorf("expected a new token after rotation, got %d token requests", requests)
+	}
+
+	// A rejected secret drops the cached token instead of serving it until it expires.
+	writeSecret("revoked")
+	if _, err := tm.GetToken(context.Background()); err == nil {
+		t.Fatalf("expected an error for a revoked client secret")
+	}
+	if tm.tokenInfo.Token != "" {
+		t.Errorf("expected the cached token to be dropped, got %q", tm.tokenInfo.Token)
+	}
+}
+
+func TestSecretClientSecret(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "sic"},
+		Data:       map[string][]byte{"clientSecret": []byte("first")},
+	}
+	if err := indexer.Add(secret); err != nil {
+		t.Fatal(err)
+	}
+	lister := corelisters.NewSecretLister(indexer).Secrets("kube-system")
+
+	s := NewSecretClientSecret(lister, "sic", "clientSecret")
+	if got, err := s.ClientSecret(context.Background()); err != nil || got != "first" {
+		t.Fatalf("expected client secret %q, got %q (error: %v)", "first", got, err)
+	}
+
+	rotated := secret.DeepCopy()
+	rotated.Data["clientSecret"] = []byte("second")
+	if err := indexer.Update(rotated); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.ClientSecret(context.Background()); err != nil || got != "second" {
+		t.Errorf("expected rotated client secret %q, got %q (error: %v)", "second", got, err)
+	}
+
+	if _, err := NewSecretClientSecret(lister, "sic", "missing").ClientSecret(context.Background()); err == nil {
+		t.Errorf("expected an error for a missing key")
+	}
+	if _, err := NewSecretClientSecret(lister, "missing", "clientSecret").ClientSecret(context.Background()); err == nil {
+		t.Errorf("expected an error for a missing Secret")
+	}
+}
+
+func TestTokenManagerConcurrentCallersShareOneRequest(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+	tm := NewTokenManager(TokenConfig{URL: server.URL, ClientID: "id", ClientSecret: StaticClientSecret("secret")})
+
+	const callers = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := tm.GetToken(context.Background()); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	// Give the callers time to pile up behind the in-flight request.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single token request, got %d", got)
+	}
+}
+
+func TestTokenManagerRefreshesAheadOfExpiry(t *testing.T) {
+	var mu sync.Mutex
+	var grants []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		grants = append(grants, r.PostForm.Get("grant_type"))
+		switch r.PostForm.Get("grant_type") {
+		case "client_credentials":
+			w.Write([]byte(`{"access_token": "generated", "expires_in": 600, "refresh_token": "refresh"}`))
+		case "refresh_token":
+			if r.PostForm.Get("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(fmt.Sprintf(`{"access_token": "refreshed-%d", "expires_in": 600}`, len(grants)-1)))
+		}
+	}))
+	defer server.Close()
+
+	var now atomic.Pointer[time.Time]
+	setNow := func(t time.Time) { now.Store(&t) }
+	setNow(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	tm := NewTokenManager(TokenConfig{URL: server.URL, ClientID: "id", ClientSecret: StaticClientSecret("secret"), ExpirySkew: time.Minute})
+	tm.now = func() time.Time { return *now.Load() }
+
+	for _, step := range []struct {
+		advance time.Duration
+		first   string
+		want    string
+	}{
+		{advance: 0, first: "generated", want: "generated"},
+		{advance: 8 * time.Minute, first: "generated", want: "generated"},
+		// Within the skew margin of the expiry, the token is still returned while it is refreshed
+		// with the refresh token in the background.
+		{advance: time.Minute, first: "generated", want: "refreshed-1"},
+		// The refresh token is kept when the server does not rotate it.
+		{advance: 9 * time.Minute, first: "refreshed-1", want: "refreshed-2"},
+	} {
+		setNow(now.Load().Add(step.advance))
+		token, err := tm.GetToken(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token != step.first {
+			t.Errorf("expected token %q after %v, got %q", step.first, step.advance, token)
+		}
+		if err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+			token, err = tm.GetToken(ctx)
+			return token == step.want, err
+		}); err != nil {
+			t.Errorf("expected token %q after %v, got %q: %v", step.want, step.advance, token, err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"client_credentials", "refresh_token", "refresh_token"}; !reflect.DeepEqual(grants, want) {
+		t.Errorf("expected grants %v, got %v", want, grants)
+	}
+}
+
+func TestTokenManagerTokenLifetime(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresIn string
+		// fresh is how long a token is used without refreshing it, valid how long it is used at all.
+		fresh, valid time.Duration
+	}{
+		{
+			name:      "skew clamped to half the lifetime",
+			expiresIn: `, "expires_in": 20`,
+			fresh:     10 * time.Second,
+			valid:     20 * time.Second,
+		},
+		{
+			name:  "missing expires_in",
+			fresh: defaultTokenLifetime - DefaultExpirySkew,
+			valid: defaultTokenLifetime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Write([]byte(`{"access_token": "token"` + tt.expiresIn + `}`))
+			}))
+			defer server.Close()
+
+			issuedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			now := issuedAt
+			tm := NewTokenManager(TokenConfig{URL: server.URL, ClientID: "id", ClientSecret: StaticClientSecret("secret")})
+			tm.now = func() time.Time { return now }
+
+			for _, at := range []time.Duration{0, tt.fresh - time.Second} {
+				now = issuedAt.Add(at)
+				if _, err := tm.GetToken(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("expected the token to be reused until it is due to be refreshed, got %d requests", got)
+			}
+			if _, fresh, ok := tm.validToken("secret"); !ok || !fresh {
+				t.Errorf("expected a fresh token %v after it was issued", tt.fresh-time.Second)
+			}
+			now = issuedAt.Add(tt.fresh)
+			if _, fresh, ok := tm.validToken("secret"); !ok || fresh {
+				t.Errorf("expected a valid token due to be refreshed %v after it was issued", tt.fresh)
+			}
+			now = issuedAt.Add(tt.valid)
+			if _, _, ok := tm.validToken("secret"); ok {
+				t.Errorf("expected the token to expire %v after it was issued", tt.valid)
+			}
+		})
+	}
+}
@@ -19,16 +19,18 @@ func NewDecayParameters(latestTime time.Time, decayRate float64) DecayParameters
 	}
 }
 
-// EmissionDataPoint holds data for a single CO₂ emission entry.
+// EmissionDataPoint holds data for a single CO₂ emission and energy consumption entry.
 type EmissionDataPoint struct {
 	Co2  float64   // CO₂ emission value (metric tons)
+	Kwh  float64   // Energy consumption value (kWh)
 	Time time.Time // Timestamp of the emission data point
 }
 
 // NewEmissionDataPoint creates a new instance of EmissionDataPoint.
-func NewEmissionDataPoint(co2 float64, timestamp time.Time) EmissionDataPoint {
+func NewEmissionDataPoint(co2, kwh float64, timestamp time.Time) EmissionDataPoint {
 	return EmissionDataPoint{
 		Co2:  co2,
+		Kwh:  kwh,
 		Time: timestamp,
 	}
 }
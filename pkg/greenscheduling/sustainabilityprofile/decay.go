@@ -0,0 +1,152 @@
+package sustainabilityprofile
+
+import (
+	"math"
+	"time"
+)
+
+// DecayModel weighs the data points of a time series by their age, i.e. the time elapsed between
+// a data point and the latest one, so that recent data points count more than older ones.
+type DecayModel interface {
+	// Weights returns the weight of every data point, given their ages.
+	Weights(ages []time.Duration) []float64
+}
+
+var (
+	_ DecayModel = ExponentialDecay{}
+	_ DecayModel = LogisticDecay{}
+	_ DecayModel = LinearDecay{}
+	_ DecayModel = SlidingWindowDecay{}
+	_ DecayModel = EWMADecay{}
+)
+
+// ExponentialDecay weighs a data point e^(-rate*age), with the age in hours, so the latest data
+// point has a weight of 1.
+type ExponentialDecay struct {
+	Rate float64 // Decay rate per hour
+}
+
+// NewExponentialDecay creates a new instance of ExponentialDecay.
+func NewExponentialDecay(rate float64) ExponentialDecay {
+	return ExponentialDecay{Rate: rate}
+}
+
+// Weights returns the exponentially decayed weight of every data point.
+func (d ExponentialDecay) Weights(ages []time.Duration) []float64 {
+	weights := make([]float64, len(ages))
+	for i, age := range ages {
+		weights[i] = 1
+		if age >= 0 {
+			weights[i] = math.Exp(-d.Rate * age.Hours())
+		}
+	}
+	return weights
+}
+
+// LogisticDecay weighs a data point e^(-rate*age) / (1 + e^(-rate*age)), with the age in hours,
+// so the latest data point has a weight of 0.5. It is the model of CalculateDecayFactor.
+type LogisticDecay struct {
+	Rate float64 // Decay rate per hour
+}
+
+// NewLogisticDecay creates a new instance of LogisticDecay.
+func NewLogisticDecay(rate float64) LogisticDecay {
+	return LogisticDecay{Rate: rate}
+}
+
+// Weights returns the logistically decayed weight of every data point.
+func (d LogisticDecay) Weights(ages []time.Duration) []float64 {
+	weights := make([]float64, len(ages))
+	for i, age := range ages {
+		weights[i] = 1
+		if age >= 0 {
+			decay := math.Exp(-d.Rate * age.Hours())
+			weights[i] = decay / (1 + decay)
+		}
+	}
+	return weights
+}
+
+// LinearDecay weighs a data point 1 - age/window, so the latest data point has a weight of 1
+// and data points older than the window are ignored.
+type LinearDecay struct {
+	Window time.Duration // Age at which the weight reaches 0
+}
+
+// NewLinearDecay creates a new instance of LinearDecay.
+func NewLinearDecay(window time.Duration) LinearDecay {
+	return LinearDecay{Window: window}
+}
+
+// Weights returns the linearly decayed weight of every data point.
+func (d LinearDecay) Weights(ages []time.Duration) []float64 {
+	weights := make([]float64, len(ages))
+	for i, age := range ages {
+		switch {
+		case age < 0:
+			weights[i] = 1
+		case age < d.Window:
+			weights[i] = 1 - float64(age)/float64(d.Window)
+		}
+	}
+	return weights
+}
+
+// SlidingWindowDecay averages the data points within the window: each of the n data points no
+// older than the window has a weight of 1/n, older ones are ignored.
+type SlidingWindowDecay struct {
+	Window time.Duration // Maximum age of the averaged data points
+}
+
+// NewSlidingWindowDecay creates a new instance of SlidingWindowDecay.
+func NewSlidingWindowDecay(window time.Duration) SlidingWindowDecay {
+	return SlidingWindowDecay{Window: window}
+}
+
+// Weights returns the sliding window average weight of every data point.
+func (d SlidingWindowDecay) Weights(ages []time.Duration) []float64 {
+	weights := make([]float64, len(ages))
+	n := 0
+	for _, age := range ages {
+		if age <= d.Window {
+			n++
+		}
+	}
+	for i, age := range ages {
+		if age <= d.Window {
+			weights[i] = 1 / float64(n)
+		}
+	}
+	return weights
+}
+
+// EWMADecay is an exponentially weighted moving average: a data point's weight halves with every
+// half-life of age, and the weights are normalised to sum up to 1.
+type EWMADecay struct {
+	HalfLife time.Duration // Age at which the weight of a data point halves
+}
+
+// NewEWMADecay creates a new instance of EWMADecay.
+func NewEWMADecay(halfLife time.Duration) EWMADecay {
+	return EWMADecay{HalfLife: halfLife}
+}
+
+// Weights returns the exponentially weighted moving average weight of every data point.
+func (d EWMADecay) Weights(ages []time.Duration) []float64 {
+	weights := make([]float64, len(ages))
+	total := 0.0
+	for i, age := range ages {
+		if age < 0 {
+			age = 0
+		}
+		weights[i] = math.Pow(0.5, float64(age)/float64(d.HalfLife))
+		total += weights[i]
+	}
+	if total == 0 {
+		return weights
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
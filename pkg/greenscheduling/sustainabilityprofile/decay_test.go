@@ -0,0 +1,77 @@
+package sustainabilityprofile
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDecayModels(t *testing.T) {
+	ages := []time.Duration{0, 12 * time.Hour, 24 * time.Hour, 48 * time.Hour}
+
+	tests := []struct {
+		name  string
+		model DecayModel
+		want  []float64
+	}{
+		{
+			name:  "exponential",
+			model: NewExponentialDecay(0.1),
+			want:  []float64{1, math.Exp(-1.2), math.Exp(-2.4), math.Exp(-4.8)},
+		},
+		{
+			name:  "logistic",
+			model: NewLogisticDecay(0.1),
+			want:  []float64{0.5, math.Exp(-1.2) / (1 + math.Exp(-1.2)), math.Exp(-2.4) / (1 + math.Exp(-2.4)), math.Exp(-4.8) / (1 + math.Exp(-4.8))},
+		},
+		{
+			name:  "linear",
+			model: NewLinearDecay(24 * time.Hour),
+			want:  []float64{1, 0.5, 0, 0},
+		},
+		{
+			name:  "sliding window",
+			model: NewSlidingWindowDecay(24 * time.Hour),
+			want:  []float64{1.0 / 3, 1.0 / 3, 1.0 / 3, 0},
+		},
+		{
+			// Unnormalised weights 1, 1/2, 1/4 and 1/16 sum up to 29/16.
+			name:  "EWMA",
+			model: NewEWMADecay(12 * time.Hour),
+			want:  []float64{16.0 / 29, 8.0 / 29, 4.0 / 29, 1.0 / 29},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.Weights(ages)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d weights, got %d", len(tt.want), len(got))
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("expected weights %v, got %v", tt.want, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateScoreDefaultsToLogisticDecay(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	profile := New([]EmissionDataPoint{
+		NewEmissionDataPoint(4, 0, now.Add(-24*time.Hour)),
+		NewEmissionDataPoint(2, 0, now),
+	}, 0, 0, 0)
+
+	weights := NewSustainabilityWeights(1, 0, 0, 0.1, 0, 0)
+	if got, want := profile.CalculateScore(weights), profile.CalculateScore(weights.WithDecayModel(NewLogisticDecay(0.1))); got != want {
+		t.Errorf("expected the logistic decay model by default, got score %v instead of %v", got, want)
+	}
+
+	// With the latest data point weighing 1 and the older one 0, only the latest emissions count.
+	if got, want := profile.CalculateScore(weights.WithDecayModel(NewLinearDecay(24*time.Hour))), 1.0/3; math.Abs(got-want) > 1e-9 {
+		t.Errorf("expected score %v with the linear decay model, got %v", want, got)
+	}
+}
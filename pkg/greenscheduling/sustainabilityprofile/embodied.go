@@ -0,0 +1,36 @@
+package sustainabilityprofile
+
+import "time"
+
+// Hardware identifies the hardware of a node, used to look up its embodied carbon.
+type Hardware struct {
+	Make           string    // Manufacturer of the hardware
+	Model          string    // Model of the hardware
+	ManufacturedAt time.Time // Manufacture time of the hardware, zero if unknown
+}
+
+// EmbodiedCarbon holds the embodied CO₂ of the hardware of a node, i.e. the CO₂ emitted to
+// manufacture it, amortised linearly over the expected lifetime of the hardware.
+type EmbodiedCarbon struct {
+	Footprint float64 // Embodied CO₂ emissions (metric tons)
+	Amortized float64 // Part of the footprint amortised by the age of the hardware (metric tons)
+}
+
+// NewEmbodiedCarbon creates a new instance of EmbodiedCarbon for hardware of the given age.
+func NewEmbodiedCarbon(footprint float64, lifetime, age time.Duration) EmbodiedCarbon {
+	amortizedShare := 1.0
+	if age <= 0 {
+		amortizedShare = 0
+	} else if age < lifetime {
+		amortizedShare = float64(age) / float64(lifetime)
+	}
+	return EmbodiedCarbon{
+		Footprint: footprint,
+		Amortized: footprint * amortizedShare,
+	}
+}
+
+// Remaining returns the part of the footprint not amortised yet.
+func (e EmbodiedCarbon) Remaining() float64 {
+	return e.Footprint - e.Amortized
+}
@@ -1,57 +1,76 @@
 package sustainabilityprofile
 
+import "time"
+
 // SustainabilityProfile holds the complete data for sustainability score calculations,
-// including emission data points, total CO₂, and total cost values.
+// including emission data points, total CO₂, total cost, and total energy values.
 type SustainabilityProfile struct {
-	Emissions []EmissionDataPoint // Individual CO₂ and time entries
+	Emissions []EmissionDataPoint // Individual CO₂, energy and time entries
 	TotalCo2  float64             // Total CO₂ emissions (metric tons), provided by the user
 	TotalCost float64             // Total cost (USD), provided by the user
+	TotalKwh  float64             // Total energy consumption (kWh), provided by the user
+	Hardware  Hardware            // Hardware of the node, if known
+	Embodied  *EmbodiedCarbon     // Embodied CO₂ of the hardware, nil if unknown
 }
 
 // New creates a new instance of SustainabilityProfile .
-func New(emissions []EmissionDataPoint, totalCo2, totalCost float64) SustainabilityProfile {
+func New(emissions []EmissionDataPoint, totalCo2, totalCost, totalKwh float64) SustainabilityProfile {
 	return SustainabilityProfile{
 		Emissions: emissions,
 		TotalCo2:  totalCo2,
 		TotalCost: totalCost,
+		TotalKwh:  totalKwh,
 	}
 }
 
+// ScoreBreakdown holds the weighted components a sustainability score is the sum of.
+type ScoreBreakdown struct {
+	CO2Decay    float64 `json:"co2Decay"`    // Score of the decayed CO₂ emissions
+	TotalCO2    float64 `json:"totalCO2"`    // Score of the total CO₂ emissions
+	Cost        float64 `json:"cost"`        // Score of the total cost
+	EnergyDecay float64 `json:"energyDecay"` // Score of the decayed energy consumption
+	Energy      float64 `json:"energy"`      // Score of the total energy consumption
+	EmbodiedCO2 float64 `json:"embodiedCO2"` // Score of the embodied CO₂ of the hardware
+}
+
+// Total returns the sustainability score, i.e. the sum of the components.
+func (b ScoreBreakdown) Total() float64 {
+	return b.CO2Decay + b.TotalCO2 + b.Cost + b.EnergyDecay + b.Energy + b.EmbodiedCO2
+}
+
 // CalculateScore calculates the overall sustainability score based on the given weights.
 func (data *SustainabilityProfile) CalculateScore(weights SustainabilityWeights) float64 {
-	co2WeightedScore := data.calculateCO2WeightedScore(weights.CO2DecayWeight, weights.DecayRate)
-	totalCO2WeightedScore := data.calculateTotalCO2WeightedScore(weights.TotalCO2Weight)
-	costWeightedScore := data.calculateCostWeightedScore(weights.CostWeight)
-
-	return co2WeightedScore + totalCO2WeightedScore + costWeightedScore
+	return data.CalculateScoreBreakdown(weights).Total()
 }
 
-// calculateCO2WeightedScore calculates the CO₂ weighted score using the decay parameters.
-func (data *SustainabilityProfile) calculateCO2WeightedScore(co2Weight float64, decayRate float64) float64 {
-	n := len(data.Emissions)
-	if n == 0 {
-		return 0
+// CalculateScoreBreakdown calculates the weighted components of the sustainability score based
+// on the given weights.
+func (data *SustainabilityProfile) CalculateScoreBreakdown(weights SustainabilityWeights) ScoreBreakdown {
+	model := weights.decayModel()
+	return ScoreBreakdown{
+		CO2Decay:    data.calculateCO2WeightedScore(weights.CO2DecayWeight, model),
+		TotalCO2:    data.calculateTotalCO2WeightedScore(weights.TotalCO2Weight),
+		Cost:        data.calculateCostWeightedScore(weights.CostWeight),
+		EnergyDecay: data.calculateEnergyWeightedScore(weights.EnergyDecayWeight, model),
+		Energy:      data.calculateTotalEnergyWeightedScore(weights.EnergyWeight),
+		EmbodiedCO2: data.calculateEmbodiedCarbonWeightedScore(weights.EmbodiedCarbonWeight, weights.PreferNewerHardware),
 	}
+}
 
-	weightedCO2 := 0.0
-
-	// Get the latest timestamp from the emissions data
-	latestTime := data.Emissions[0].Time
-	for _, emission := range data.Emissions {
-		if emission.Time.After(latestTime) {
-			latestTime = emission.Time
-		}
-	}
+// calculateCO2WeightedScore calculates the CO₂ weighted score using the decay model.
+func (data *SustainabilityProfile) calculateCO2WeightedScore(co2Weight float64, model DecayModel) float64 {
+	weightedCO2, _ := data.decayedSum(model, func(emission EmissionDataPoint) float64 { return emission.Co2 })
+	return co2Weight / (1 + weightedCO2)
+}
 
-	for _, emission := range data.Emissions {
-		decayFactor := emission.CalculateDecayFactor(NewDecayParameters(
-			latestTime,
-			decayRate,
-		))
-		weightedCO2 += decayFactor * emission.Co2
+// DecayedCO2 returns the trend of the CO₂ emissions, i.e. their average weighted by the decay
+// model, so that recent data points dominate. It returns 0 if there are no data points.
+func (data *SustainabilityProfile) DecayedCO2(model DecayModel) float64 {
+	weightedCO2, totalWeight := data.decayedSum(model, func(emission EmissionDataPoint) float64 { return emission.Co2 })
+	if totalWeight == 0 {
+		return 0
 	}
-
-	return co2Weight / (1 + weightedCO2)
+	return weightedCO2 / totalWeight
 }
 
 // calculateTotalCO2WeightedScore calculates the total CO₂ weighted score based on the total emissions.
@@ -63,3 +82,60 @@ func (data *SustainabilityProfile) calculateTotalCO2WeightedScore(totalCO2Weight
 func (data *SustainabilityProfile) calculateCostWeightedScore(costWeight float64) float64 {
 	return costWeight / (1 + data.TotalCost)
 }
+
+// calculateEnergyWeightedScore calculates the energy weighted score using the decay model.
+func (data *SustainabilityProfile) calculateEnergyWeightedScore(energyWeight float64, model DecayModel) float64 {
+	weightedKwh, _ := data.decayedSum(model, func(emission EmissionDataPoint) float64 { return emission.Kwh })
+	return energyWeight / (1 + weightedKwh)
+}
+
+// calculateEmbodiedCarbonWeightedScore calculates the embodied carbon weighted score. By default,
+// hardware whose embodied carbon is already amortised, i.e. older hardware, scores higher; when
+// newer hardware is preferred, hardware whose embodied carbon is still to be amortised scores higher.
+// Hardware with unknown embodied carbon does not score.
+func (data *SustainabilityProfile) calculateEmbodiedCarbonWeightedScore(embodiedCarbonWeight float64, preferNewerHardware bool) float64 {
+	if data.Embodied == nil {
+		return 0
+	}
+	if preferNewerHardware {
+		return embodiedCarbonWeight / (1 + data.Embodied.Amortized)
+	}
+	return embodiedCarbonWeight / (1 + data.Embodied.Remaining())
+}
+
+// decayedSum returns the sum of the values of the data points weighted by the decay model, and
+// the sum of the weights. Ages are relative to the latest data point.
+func (data *SustainabilityProfile) decayedSum(model DecayModel, value func(EmissionDataPoint) float64) (float64, float64) {
+	if len(data.Emissions) == 0 {
+		return 0, 0
+	}
+
+	latestTime := data.latestTime()
+	ages := make([]time.Duration, len(data.Emissions))
+	for i, emission := range data.Emissions {
+		ages[i] = latestTime.Sub(emission.Time)
+	}
+
+	weightedSum, totalWeight := 0.0, 0.0
+	for i, weight := range model.Weights(ages) {
+		weightedSum += weight * value(data.Emissions[i])
+		totalWeight += weight
+	}
+	return weightedSum, totalWeight
+}
+
+// calculateTotalEnergyWeightedScore calculates the total energy weighted score based on the total energy consumption.
+func (data *SustainabilityProfile) calculateTotalEnergyWeightedScore(energyWeight float64) float64 {
+	return energyWeight / (1 + data.TotalKwh)
+}
+
+// latestTime returns the latest timestamp of the emissions data.
+func (data *SustainabilityProfile) latestTime() time.Time {
+	latestTime := data.Emissions[0].Time
+	for _, emission := range data.Emissions {
+		if emission.Time.After(latestTime) {
+			latestTime = emission.Time
+		}
+	}
+	return latestTime
+}
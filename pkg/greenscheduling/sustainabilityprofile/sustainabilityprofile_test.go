@@ -0,0 +1,111 @@
+package sustainabilityprofile
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCalculateScoreEnergyTerms(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	emissions := []EmissionDataPoint{
+		NewEmissionDataPoint(0, 10, now.Add(-time.Hour)),
+		NewEmissionDataPoint(0, 4, now),
+	}
+
+	tests := []struct {
+		name    string
+		profile SustainabilityProfile
+		weights SustainabilityWeights
+		want    float64
+	}{
+		{
+			name:    "energy weights disabled",
+			profile: New(emissions, 0, 0, 14),
+			weights: NewSustainabilityWeights(0, 0, 0, 0, 0, 0),
+			want:    0,
+		},
+		{
+			name:    "total energy",
+			profile: New(emissions, 0, 0, 14),
+			weights: NewSustainabilityWeights(0, 0, 0, 0, 0, 3),
+			want:    3.0 / 15,
+		},
+		{
+			// Without decay every data point counts half: 1 / (1 + 5 + 2).
+			name:    "decayed energy without decay",
+			profile: New(emissions, 0, 0, 14),
+			weights: NewSustainabilityWeights(0, 0, 0, 0, 1, 0),
+			want:    1.0 / 8,
+		},
+		{
+			name:    "lower consumption scores higher",
+			profile: New(emissions[1:], 0, 0, 4),
+			weights: NewSustainabilityWeights(0, 0, 0, 0, 0, 3),
+			want:    3.0 / 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.profile.CalculateScore(tt.weights); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("expected score %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDecayedCO2(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	profile := New([]EmissionDataPoint{
+		NewEmissionDataPoint(10, 0, now.Add(-48*time.Hour)),
+		NewEmissionDataPoint(2, 0, now),
+	}, 12, 0, 0)
+
+	// Without decay the trend is the plain average.
+	if got := profile.DecayedCO2(NewLogisticDecay(0)); math.Abs(got-6) > 1e-9 {
+		t.Errorf("expected trend 6 without decay, got %v", got)
+	}
+	// With decay the trend follows the latest data point.
+	if got := profile.DecayedCO2(NewLogisticDecay(0.5)); got >= 3 {
+		t.Errorf("expected the trend to follow the latest data point, got %v", got)
+	}
+	empty := New(nil, 0, 0, 0)
+	if got := empty.DecayedCO2(NewLogisticDecay(0.5)); got != 0 {
+		t.Errorf("expected trend 0 without data points, got %v", got)
+	}
+}
+
+func TestCalculateScoreEmbodiedCarbon(t *testing.T) {
+	const year = 365 * 24 * time.Hour
+	older := New(nil, 0, 0, 0)
+	olderEmbodied := NewEmbodiedCarbon(2, 4*year, 3*year)
+	older.Embodied = &olderEmbodied
+	newer := New(nil, 0, 0, 0)
+	newerEmbodied := NewEmbodiedCarbon(2, 4*year, year)
+	newer.Embodied = &newerEmbodied
+	unknown := New(nil, 0, 0, 0)
+
+	if olderEmbodied.Amortized != 1.5 || olderEmbodied.Remaining() != 0.5 {
+		t.Errorf("expected 1.5 amortised and 0.5 remaining tons after 3 of 4 years, got %+v", olderEmbodied)
+	}
+	if beyond := NewEmbodiedCarbon(2, 4*year, 6*year); beyond.Remaining() != 0 {
+		t.Errorf("expected hardware beyond its lifetime to be fully amortised, got %+v", beyond)
+	}
+
+	amortized := NewSustainabilityWeights(0, 0, 0, 0, 0, 0).WithEmbodiedCarbon(1, false)
+	if got, want := older.CalculateScore(amortized), 1/1.5; math.Abs(got-want) > 1e-9 {
+		t.Errorf("expected score %v for older hardware, got %v", want, got)
+	}
+	if older.CalculateScore(amortized) <= newer.CalculateScore(amortized) {
+		t.Errorf("expected older hardware to score higher when amortised hardware is preferred")
+	}
+
+	preferNewer := amortized.WithEmbodiedCarbon(1, true)
+	if newer.CalculateScore(preferNewer) <= older.CalculateScore(preferNewer) {
+		t.Errorf("expected newer hardware to score higher when newer hardware is preferred")
+	}
+	if got := unknown.CalculateScore(preferNewer); got != 0 {
+		t.Errorf("expected hardware with unknown embodied carbon not to score, got %v", got)
+	}
+}
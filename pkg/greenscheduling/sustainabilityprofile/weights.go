@@ -5,29 +5,65 @@ type WeightType int
 
 const (
 	// Define weight types as constants
-	CO2Decay  WeightType = iota // 0
-	TotalCO2                    // 1
-	Cost                        // 2
-	DecayRate                   // 3
+	CO2Decay    WeightType = iota // 0
+	TotalCO2                      // 1
+	Cost                          // 2
+	DecayRate                     // 3
+	EnergyDecay                   // 4
+	Energy                        // 5
+	EmbodiedCO2                   // 6
 )
 
 // SustainabilityWeights holds the weights for different components of the sustainability score.
 type SustainabilityWeights struct {
-	CO2DecayWeight float64 // Weight for the CO₂ emissions with decay
-	TotalCO2Weight float64 // Weight for the total CO₂ emissions
-	CostWeight     float64 // Weight for the cost
-	DecayRate      float64 // Decay rate for CO₂ emissions
+	CO2DecayWeight    float64 // Weight for the CO₂ emissions with decay
+	TotalCO2Weight    float64 // Weight for the total CO₂ emissions
+	CostWeight        float64 // Weight for the cost
+	DecayRate         float64 // Decay rate for CO₂ emissions and energy consumption
+	EnergyDecayWeight float64 // Weight for the energy consumption with decay
+	EnergyWeight      float64 // Weight for the total energy consumption
+
+	EmbodiedCarbonWeight float64 // Weight for the embodied CO₂ of the hardware
+	PreferNewerHardware  bool    // Favour hardware whose embodied CO₂ is still to be amortised
+
+	// DecayModel weighs CO₂ emissions and energy consumption by their age, a LogisticDecay
+	// with the DecayRate if nil.
+	DecayModel DecayModel
 }
 
 // NewSustainabilityWeights creates a new instance of SustainabilityWeights.
 // It requires all weight parameters to be provided.
-func NewSustainabilityWeights(co2DecayWeight, totalCO2Weight, costWeight, decayRate float64) SustainabilityWeights {
+func NewSustainabilityWeights(co2DecayWeight, totalCO2Weight, costWeight, decayRate, energyDecayWeight, energyWeight float64) SustainabilityWeights {
 	return SustainabilityWeights{
-		CO2DecayWeight: co2DecayWeight,
-		TotalCO2Weight: totalCO2Weight,
-		CostWeight:     costWeight,
-		DecayRate:      decayRate,
+		CO2DecayWeight:    co2DecayWeight,
+		TotalCO2Weight:    totalCO2Weight,
+		CostWeight:        costWeight,
+		DecayRate:         decayRate,
+		EnergyDecayWeight: energyDecayWeight,
+		EnergyWeight:      energyWeight,
+	}
+}
+
+// WithDecayModel returns a copy of the weights using the given decay model.
+func (w SustainabilityWeights) WithDecayModel(model DecayModel) SustainabilityWeights {
+	w.DecayModel = model
+	return w
+}
+
+// WithEmbodiedCarbon returns a copy of the weights scoring the embodied CO₂ of the hardware with
+// the given weight, favouring older or newer hardware.
+func (w SustainabilityWeights) WithEmbodiedCarbon(weight float64, preferNewerHardware bool) SustainabilityWeights {
+	w.EmbodiedCarbonWeight = weight
+	w.PreferNewerHardware = preferNewerHardware
+	return w
+}
+
+// decayModel returns the decay model of the weights.
+func (w SustainabilityWeights) decayModel() DecayModel {
+	if w.DecayModel == nil {
+		return NewLogisticDecay(w.DecayRate)
 	}
+	return w.DecayModel
 }
 
 // GetWeight returns the appropriate weight based on the WeightType.
@@ -41,6 +77,12 @@ func (w SustainabilityWeights) GetWeight(weightType WeightType) float64 {
 		return w.CostWeight
 	case DecayRate:
 		return w.DecayRate
+	case EnergyDecay:
+		return w.EnergyDecayWeight
+	case Energy:
+		return w.EnergyWeight
+	case EmbodiedCO2:
+		return w.EmbodiedCarbonWeight
 	default:
 		return 0.0 // Return 0 for an invalid weight type
 	}
@@ -1,21 +1,25 @@
 package greenscheduling
 
 import (
-	"errors"
-
 	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
 )
 
-// ValidateGreenSchedulingArgs checks if all required args are present.
-func ValidateGreenSchedulingArgs(args *config.GreenSchedulingArgs) error {
-	if args.TokenURL == "" || args.ClientID == "" || args.ClientSecret == "" || args.SICHostname == "" || args.SerialNumLabel == "" {
-		return errors.New("missing required arguments")
-	}
-	if args.CO2DecayWeight < 0 || args.TotalCO2Weight < 0 || args.CostWeight < 0 || args.DecayRate < 0 || args.DecayRate > 1 {
-		return errors.New("invalid weight or decay rate")
+// validateSustainabilityWeights validates the weight overrides of namespaces and pods with the
+// same rules as the configured weights, along with the configured embodied carbon weight, which
+// cannot be overridden.
+func validateSustainabilityWeights(weights SustainabilityWeights, embodiedCarbonWeight float64) error {
+	args := &config.GreenSchedulingArgs{
+		CO2DecayWeight:    weights.CO2DecayWeight,
+		TotalCO2Weight:    weights.TotalCO2Weight,
+		CostWeight:        weights.CostWeight,
+		DecayRate:         weights.DecayRate,
+		EnergyDecayWeight: weights.EnergyDecayWeight,
+		EnergyWeight:      weights.EnergyWeight,
+		EmbodiedCarbon:    config.EmbodiedCarbonSpec{Weight: embodiedCarbonWeight},
 	}
-	if args.TimeSeriesInterval == "" || args.ConsiderationDays <= 0 {
-		return errors.New("invalid interval or consideration days")
+	if errs := validation.ValidateGreenSchedulingWeights(nil, args); len(errs) > 0 {
+		return errs.ToAggregate()
 	}
 	return nil
 }
@@ -0,0 +1,66 @@
+package greenscheduling
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// Keys of the pod annotations and namespace labels overriding the configured sustainability weights.
+const (
+	AnnotationKeyCO2DecayWeight = AnnotationKeyPrefix + "co2-decay-weight"
+	AnnotationKeyTotalCO2Weight = AnnotationKeyPrefix + "total-co2-weight"
+	AnnotationKeyCostWeight     = AnnotationKeyPrefix + "cost-weight"
+	AnnotationKeyDecayRate      = AnnotationKeyPrefix + "decay-rate"
+)
+
+// effectiveWeights returns the sustainability weights of a pod: the configured weights, overridden
+// by the labels of the pod's namespace, which are in turn overridden by the annotations of the pod.
+// Invalid overrides are logged and ignored.
+func (gks *GreenScheduling) effectiveWeights(pod *v1.Pod) SustainabilityWeights {
+	weights := gks.config.SustainabilityWeights
+
+	if namespace, err := gks.namespaceLister.Get(pod.Namespace); err == nil {
+		if overridden, err := overrideWeights(weights, namespace.Labels, gks.config.EmbodiedCarbonConfig.Weight); err != nil {
+			klog.ErrorS(err, "Ignoring invalid sustainability weights of namespace", "namespace", pod.Namespace)
+		} else {
+			weights = overridden
+		}
+	}
+
+	if overridden, err := overrideWeights(weights, pod.Annotations, gks.config.EmbodiedCarbonConfig.Weight); err != nil {
+		klog.ErrorS(err, "Ignoring invalid sustainability weights of pod", "pod", klog.KObj(pod))
+	} else {
+		weights = overridden
+	}
+
+	return weights
+}
+
+// overrideWeights returns the weights overridden by the given annotations or labels. The overridden
+// weights are validated like the configured ones, along with the configured embodied carbon weight.
+func overrideWeights(weights SustainabilityWeights, overrides map[string]string, embodiedCarbonWeight float64) (SustainabilityWeights, error) {
+	overridden := weights
+	for key, field := range map[string]*float64{
+		AnnotationKeyCO2DecayWeight: &overridden.CO2DecayWeight,
+		AnnotationKeyTotalCO2Weight: &overridden.TotalCO2Weight,
+		AnnotationKeyCostWeight:     &overridden.CostWeight,
+		AnnotationKeyDecayRate:      &overridden.DecayRate,
+	} {
+		value, ok := overrides[key]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return weights, fmt.Errorf("invalid value %q of %s: %w", value, key, err)
+		}
+		*field = parsed
+	}
+	if err := validateSustainabilityWeights(overridden, embodiedCarbonWeight); err != nil {
+		return weights, err
+	}
+	return overridden, nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubernetes/pkg/scheduler"
+	schedapi "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	fwkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
+	imageutils "k8s.io/kubernetes/test/utils/image"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicfake"
+	"sigs.k8s.io/scheduler-plugins/pkg/greenscheduling/sicclient/sicresponse"
+	"sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func TestGreenSchedulingPlugin(t *testing.T) {
+	testCtx := &testContext{}
+	testCtx.Ctx, testCtx.CancelFn = context.WithCancel(context.Background())
+
+	cs := kubernetes.NewForConfigOrDie(globalKubeConfig)
+	testCtx.ClientSet = cs
+	testCtx.KubeConfig = globalKubeConfig
+
+	// node-2 emits the least CO2; node-4 has no serial number and cannot be scored.
+	nodeSerialNums := map[string]string{"node-1": "SN1", "node-2": "SN2", "node-3": "SN3"}
+	serialNumCO2 := map[string]float64{"SN1": 3, "SN2": 0.5, "SN3": 2}
+	var entities []sicfake.Entity
+	for serialNum, co2 := range serialNumCO2 {
+		entities = append(entities, sicfake.Entity{
+			UsageEntity: sicresponse.UsageEntity{EntitySerialNum: serialNum, Co2eMetricTon: ptr.To(co2)},
+			Series: []sicresponse.UsageSeriesItem{
+				{TimeBucket: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), Co2eMetricTon: ptr.To(co2 / 24)},
+			},
+		})
+	}
+	server := sicfake.NewServer(entities...)
+	defer server.Close()
+	// The first usage-by-entity request fails, and is retried by the client.
+	server.InjectFaults(sicfake.EndpointUsageByEntity, sicfake.Fault{StatusCode: 503})
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := server.WriteCAFile(caFile); err != nil {
+		t.Fatal(err)
+	}
+
+	v1Args := &cfgv1.GreenSchedulingArgs{
+		TokenURL:       ptr.To(server.TokenURL()),
+		ClientID:       ptr.To(sicfake.ClientID),
+		ClientSecret:   ptr.To(sicfake.ClientSecret),
+		SICBaseURL:     ptr.To(server.BaseURL()),
+		SICCAFile:      ptr.To(caFile),
+		SerialNumLabel: ptr.To("serial-number"),
+		ScoreBreakdown: cfgv1.AlwaysScoreBreakdown,
+	}
+	cfgv1.SetDefaults_GreenSchedulingArgs(v1Args)
+	args := &config.GreenSchedulingArgs{}
+	if err := cfgv1.Convert_v1_GreenSchedulingArgs_To_config_GreenSchedulingArgs(v1Args, args, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := util.NewDefaultSchedulerComponentConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Profiles[0].Plugins.PreScore = schedapi.PluginSet{
+		Enabled:  []schedapi.Plugin{{Name: greenscheduling.Name}},
+		Disabled: []schedapi.Plugin{{Name: "*"}},
+	}
+	cfg.Profiles[0].Plugins.Score = schedapi.PluginSet{
+		Enabled:  []schedapi.Plugin{{Name: greenscheduling.Name}},
+		Disabled: []schedapi.Plugin{{Name: "*"}},
+	}
+	cfg.Profiles[0].Plugins.PostBind = schedapi.PluginSet{
+		Enabled: []schedapi.Plugin{{Name: greenscheduling.Name}},
+	}
+	cfg.Profiles[0].PluginConfig = append(cfg.Profiles[0].PluginConfig, schedapi.PluginConfig{
+		Name: greenscheduling.Name,
+		Args: args,
+	})
+
+	testCtx = initTestSchedulerWithOptions(
+		t,
+		testCtx,
+		scheduler.WithProfiles(cfg.Profiles...),
+		scheduler.WithFrameworkOutOfTreeRegistry(fwkruntime.Registry{greenscheduling.Name: greenscheduling.New}),
+	)
+	syncInformerFactory(testCtx)
+	go testCtx.Scheduler.Run(testCtx.Ctx)
+	defer cleanupTest(t, testCtx)
+
+	ns := fmt.Sprintf("integration-test-%v", string(uuid.NewUUID()))
+	createNamespace(t, testCtx, ns)
+
+	for _, nodeName := range []string{"node-1", "node-2", "node-3", "node-4"} {
+		node := st.MakeNode().Name(nodeName).Label("node", nodeName).Obj()
+		if serialNum, ok := nodeSerialNums[nodeName]; ok {
+			node.Labels["serial-number"] = serialNum
+		}
+		node.Status.Allocatable = v1.ResourceList{
+			v1.ResourcePods:   *resource.NewQuantity(32, resource.DecimalSI),
+			v1.ResourceCPU:    *resource.NewQuantity(2, resource.DecimalSI),
+			v1.ResourceMemory: *resource.NewQuantity(256, resource.DecimalSI),
+		}
+		node.Status.Capacity = node.Status.Allocatable
+		if _, err := cs.CoreV1().Nodes().Create(testCtx.Ctx, node, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Failed to create Node %q: %v", nodeName, err)
+		}
+	}
+
+	var pods []*v1.Pod
+	defer func() { cleanupPods(t, testCtx, pods) }()
+	schedulePod := func(name string) *v1.Pod {
+		pod := st.MakePod().Namespace(ns).Name(name).Container(imageutils.GetPauseImageName()).Obj()
+		if _, err := cs.CoreV1().Pods(ns).Create(testCtx.Ctx, pod, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Failed to create Pod %q: %v", name, err)
+		}
+		pods = append(pods, pod)
+		if err := wait.PollUntilContextTimeout(testCtx.Ctx, 100*time.Millisecond, 10*time.Second, false, func(ctx context.Context) (bool, error) {
+			return podScheduled(cs, ns, name), nil
+		}); err != nil {
+			t.Fatalf("Pod %q was not scheduled: %v", name, err)
+		}
+		pod, err := cs.CoreV1().Pods(ns).Get(testCtx.Ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pod
+	}
+
+	// Scores are fetched in the background once PreScore sees the serial numbers of the nodes,
+	// so the warm-up pod lands anywhere. The score cache is populated once the usage series of
+	// every serial number, fetched last, have been requested.
+	schedulePod("warm-up")
+	if err := wait.PollUntilContextTimeout(testCtx.Ctx, 100*time.Millisecond, 30*time.Second, true, func(ctx context.Context) (bool, error) {
+		return server.Requests(sicfake.EndpointUsageSeries) >= len(nodeSerialNums), nil
+	}); err != nil {
+		t.Fatalf("The score cache was not populated, after %d SIC usage-by-entity and %d usage-series requests: %v",
+			server.Requests(sicfake.EndpointUsageByEntity), server.Requests(sicfake.EndpointUsageSeries), err)
+	}
+
+	for i := 0; i < 3; i++ {
+		pod := schedulePod(fmt.Sprintf("pod-%d", i))
+		if pod.Spec.NodeName != "node-2" {
+			t.Errorf("Expected Pod %q to land on the greenest node node-2, got %q", pod.Name, pod.Spec.NodeName)
+		}
+	}
+
+	// The score breakdown is annotated after binding.
+	if err := wait.PollUntilContextTimeout(testCtx.Ctx, 100*time.Millisecond, 10*time.Second, false, func(ctx context.Context) (bool, error) {
+		pod, err := cs.CoreV1().Pods(ns).Get(ctx, "pod-0", metav1.GetOptions{})
+		return err == nil && pod.Annotations[greenscheduling.AnnotationKeyScoreBreakdown] != "", nil
+	}); err != nil {
+		t.Errorf("Expected Pod pod-0 to be annotated with its score breakdown: %v", err)
+	}
+}